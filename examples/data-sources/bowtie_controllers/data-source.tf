@@ -0,0 +1,13 @@
+data "bowtie_controllers" "east" {
+  site_id = bowtie_site.east.id
+}
+
+# Publish the public address of every Controller of the site under a
+# single name, for example with the AWS provider:
+resource "aws_route53_record" "bowtie" {
+  zone_id = var.zone_id
+  name    = "bowtie.example.com"
+  type    = "A"
+  ttl     = 300
+  records = data.bowtie_controllers.east.controllers[*].public_address
+}
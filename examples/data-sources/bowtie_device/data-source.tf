@@ -0,0 +1,3 @@
+data "bowtie_device" "build_server" {
+  serial = "C02XK1JHJG5J"
+}
@@ -0,0 +1,3 @@
+data "bowtie_device_group" "ci_runners" {
+  name = "CI Runners"
+}
@@ -0,0 +1,13 @@
+# Find devices that are still waiting to be approved:
+
+data "bowtie_devices" "pending" {
+  state = "Pending"
+}
+
+# Find macOS devices that haven't connected since the start of the year,
+# for example to offboard them:
+
+data "bowtie_devices" "stale" {
+  device_os        = "macOS"
+  last_seen_before = "2024-01-01T00:00:00Z"
+}
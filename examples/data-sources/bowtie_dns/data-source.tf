@@ -0,0 +1,7 @@
+data "bowtie_dns" "corp" {
+  name = "corp.example.com"
+}
+
+output "corp_dns_servers" {
+  value = [for server in data.bowtie_dns.corp.servers : server.addr]
+}
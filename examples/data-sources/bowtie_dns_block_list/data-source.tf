@@ -0,0 +1,7 @@
+data "bowtie_dns_block_list" "ads" {
+  name = "Advertising"
+}
+
+output "ads_block_list_upstream" {
+  value = data.bowtie_dns_block_list.ads.upstream
+}
@@ -0,0 +1,5 @@
+data "bowtie_dns_block_lists" "all" {}
+
+output "block_list_names" {
+  value = [for blocklist in data.bowtie_dns_block_lists.all.dns_block_lists : blocklist.name]
+}
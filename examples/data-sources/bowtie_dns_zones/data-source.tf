@@ -0,0 +1,4 @@
+# Every DNS zone under example.com:
+data "bowtie_dns_zones" "example" {
+  name_regex = "(^|\\.)example\\.com$"
+}
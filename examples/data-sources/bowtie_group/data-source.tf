@@ -0,0 +1,12 @@
+data "bowtie_group" "engineering" {
+  name = "Engineering"
+}
+
+# Grant a group managed elsewhere access to the internal tools:
+resource "bowtie_policy" "engineering" {
+  source = {
+    user_group_id = data.bowtie_group.engineering.id
+  }
+  dest   = bowtie_resource_group.tools.id
+  action = "Accept"
+}
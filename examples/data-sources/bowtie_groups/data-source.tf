@@ -0,0 +1,8 @@
+# Every group whose name starts with "Team ":
+data "bowtie_groups" "teams" {
+  name_regex = "^Team "
+}
+
+output "team_group_ids" {
+  value = { for group in data.bowtie_groups.teams.groups : group.name => group.id }
+}
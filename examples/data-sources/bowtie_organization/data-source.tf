@@ -0,0 +1,9 @@
+data "bowtie_organization" "current" {}
+
+# Look up a site by name without managing it in this configuration:
+locals {
+  headquarters = one([
+    for site in data.bowtie_organization.current.sites : site
+    if site.name == "Headquarters"
+  ])
+}
@@ -0,0 +1,11 @@
+# Look a resource up by its address:
+data "bowtie_resource" "lan" {
+  location = {
+    cidr = "10.0.0.0/8"
+  }
+}
+
+resource "bowtie_resource_group" "office" {
+  name      = "Office"
+  resources = [data.bowtie_resource.lan.id]
+}
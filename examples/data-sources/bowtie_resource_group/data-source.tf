@@ -0,0 +1,11 @@
+data "bowtie_resource_group" "tools" {
+  name = "Internal Tools"
+}
+
+resource "bowtie_policy" "tools" {
+  source = {
+    user_group_id = bowtie_group.engineering.id
+  }
+  dest   = data.bowtie_resource_group.tools.id
+  action = "Accept"
+}
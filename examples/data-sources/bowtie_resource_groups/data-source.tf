@@ -0,0 +1,4 @@
+# Every resource group whose name starts with "Production":
+data "bowtie_resource_groups" "production" {
+  name_regex = "^Production"
+}
@@ -0,0 +1,9 @@
+# Every HTTPS resource:
+data "bowtie_resources" "web" {
+  protocol = "https"
+}
+
+resource "bowtie_resource_group" "web" {
+  name      = "Web Applications"
+  resources = [for resource in data.bowtie_resources.web.resources : resource.id]
+}
@@ -0,0 +1,13 @@
+data "bowtie_site" "headquarters" {
+  name = "Headquarters"
+}
+
+resource "bowtie_site_range" "lab" {
+  site_id    = data.bowtie_site.headquarters.id
+  name       = "Lab"
+  ipv4_range = "10.10.0.0/16"
+}
+
+output "headquarters_ranges" {
+  value = [for r in data.bowtie_site.headquarters.ipv4_ranges : r.range]
+}
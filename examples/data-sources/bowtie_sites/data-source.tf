@@ -0,0 +1,9 @@
+data "bowtie_sites" "all" {}
+
+# The public addresses of every Controller, by site name:
+output "controller_addresses" {
+  value = {
+    for site in data.bowtie_sites.all.sites :
+    site.name => [for controller in site.controllers : controller.public_address]
+  }
+}
@@ -0,0 +1,14 @@
+# All active users at @example.com:
+data "bowtie_users" "staff" {
+  status       = "active"
+  email_domain = "example.com"
+}
+
+resource "bowtie_group" "staff" {
+  name = "Staff"
+}
+
+resource "bowtie_group_membership" "staff" {
+  group_id = bowtie_group.staff.id
+  users    = [for user in data.bowtie_users.staff.users : user.id]
+}
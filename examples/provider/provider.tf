@@ -24,3 +24,57 @@ provider "bowtie" {
   username = "example"
   password = "test1123"
 }
+
+# Authenticate with an API token instead of a username and password,
+# for example from a CI pipeline. The token can also be set through the
+# BOWTIE_API_TOKEN environment variable.
+
+provider "bowtie" {
+  host      = "https://bowtie.example.com"
+  api_token = var.bowtie_api_token
+}
+
+# Retry requests that fail while a controller is restarting for longer
+# than the default, waiting at most a minute between attempts
+
+provider "bowtie" {
+  host                   = "https://bowtie.example.com"
+  max_retries            = 6
+  retry_min_backoff      = "2s"
+  retry_max_backoff      = "1m"
+  retryable_status_codes = [429, 500, 502, 503, 504]
+}
+
+# Connect to a lab Controller whose certificate is issued by a private CA
+
+provider "bowtie" {
+  host                = "https://bowtie.lab.example.com"
+  ca_certificate_file = "${path.module}/lab-ca.pem"
+  tls_min_version     = "1.3"
+}
+
+# Fail over between the Controllers of an organization, including any
+# Controller added later on
+
+provider "bowtie" {
+  hosts = [
+    "https://bowtie-east.example.com",
+    "https://bowtie-west.example.com",
+  ]
+  discover_controllers = true
+}
+
+# Give requests to a slow Controller more time before they time out
+
+provider "bowtie" {
+  host         = "https://bowtie.example.com"
+  http_timeout = "30s"
+}
+
+# Go easy on a small Controller shared with other automation
+
+provider "bowtie" {
+  host                    = "https://bowtie.example.com"
+  requests_per_second     = 5
+  max_concurrent_requests = 2
+}
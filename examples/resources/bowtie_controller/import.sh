@@ -0,0 +1 @@
+terraform import bowtie_controller.east 6a1f3c2e-5b7d-4e9a-8c0f-1d2e3f4a5b6c
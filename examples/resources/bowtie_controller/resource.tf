@@ -0,0 +1,10 @@
+resource "bowtie_site" "east" {
+  name = "US East"
+}
+
+resource "bowtie_controller" "east" {
+  site_id        = bowtie_site.east.id
+  public_address = "203.0.113.10"
+  https_endpoint = "https://bowtie-east.example.com"
+  wireguard_port = 51820
+}
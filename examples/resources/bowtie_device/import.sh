@@ -0,0 +1 @@
+terraform import bowtie_device.laptop 3c95739e-ec9e-40ea-8dca-e03f224ebb6b
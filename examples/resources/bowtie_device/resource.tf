@@ -0,0 +1,16 @@
+# Devices enroll themselves, so bring an enrolled device under management
+# with an import block before configuring it:
+
+import {
+  to = bowtie_device.laptop
+  id = "3c95739e-ec9e-40ea-8dca-e03f224ebb6b"
+}
+
+resource "bowtie_device" "laptop" {
+  name             = "Alice's laptop"
+  assigned_to_user = bowtie_user.alice.id
+  state            = "Accepted"
+}
+
+# Offboard the device by removing the resource from your configuration,
+# which removes the device from the organization.
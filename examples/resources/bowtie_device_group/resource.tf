@@ -0,0 +1,12 @@
+resource "bowtie_device_group" "ci_runners" {
+  name = "CI Runners"
+}
+
+# Grant every CI runner access to the build tools:
+resource "bowtie_policy" "ci_runners" {
+  source = {
+    device_group_id = bowtie_device_group.ci_runners.id
+  }
+  dest   = bowtie_resource_group.build_tools.id
+  action = "Accept"
+}
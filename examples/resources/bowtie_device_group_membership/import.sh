@@ -0,0 +1 @@
+terraform import bowtie_device_group_membership.ci_runners 0c4f2d4e-8a1b-4c5e-9f3a-2b7d6e1c8a90
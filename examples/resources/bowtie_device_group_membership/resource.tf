@@ -0,0 +1,13 @@
+resource "bowtie_device_group" "ci_runners" {
+  name = "CI Runners"
+}
+
+data "bowtie_devices" "runners" {
+  device_type = "Server"
+  state       = "Accepted"
+}
+
+resource "bowtie_device_group_membership" "ci_runners" {
+  group_id = bowtie_device_group.ci_runners.id
+  devices  = data.bowtie_devices.runners.devices[*].id
+}
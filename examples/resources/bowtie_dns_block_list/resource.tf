@@ -7,3 +7,17 @@ resource "bowtie_dns_block_list" "example" {
     "permitted.example.com"
   ]
 }
+
+# Allow more time for a large block list to be fetched and validated. The
+# create and update timeouts also bound the single request that fetches it,
+# which may take longer than the provider's http_timeout:
+
+resource "bowtie_dns_block_list" "large" {
+  name     = "Large Block List"
+  upstream = "https://raw.githubusercontent.com/hagezi/dns-blocklists/main/domains/pro.txt"
+
+  timeouts {
+    create = "2m"
+    update = "2m"
+  }
+}
@@ -0,0 +1,14 @@
+# The organization always exists, so import it rather than create it:
+import {
+  to = bowtie_organization.org
+  id = "<organization ID>"
+}
+
+resource "bowtie_organization" "org" {
+  name   = "Example"
+  domain = "example.com"
+
+  # Devices are assigned addresses from these ranges, which must not
+  # overlap the IPv6 ranges of any site.
+  ipv6_ranges = ["fd00:b0e7::/48"]
+}
@@ -1,11 +1,44 @@
+resource "bowtie_group" "engineering" {
+  name = "Engineering"
+}
+
+resource "bowtie_resource" "wiki" {
+  name     = "Internal wiki"
+  protocol = "https"
+  location = {
+    dns = "wiki.example.com"
+  }
+  ports = {
+    collection = [443]
+  }
+}
+
+resource "bowtie_resource_group" "tools" {
+  name      = "Internal Tools"
+  resources = [bowtie_resource.wiki.id]
+  inherited = []
+}
+
+# Grant members of the Engineering group access to the internal tools:
 resource "bowtie_policy" "example" {
   source = {
-    user_id         = ""
-    user_group_id   = ""
-    device_id       = ""
-    device_group_id = ""
-    always          = true
+    user_group_id = bowtie_group.engineering.id
+  }
+  dest   = bowtie_resource_group.tools.id
+  action = "Accept"
+}
+
+# Combine selectors with a predicate. This grants Engineering access
+# from every device except a shared kiosk:
+resource "bowtie_policy" "predicate" {
+  source = {
+    predicate = jsonencode({
+      and = [
+        { in_user_group = bowtie_group.engineering.id },
+        { not = { device = "3c95739e-ec9e-40ea-8dca-e03f224ebb6b" } },
+      ]
+    })
   }
-  dest   = ""
+  dest   = bowtie_resource_group.tools.id
   action = "Accept"
-}
\ No newline at end of file
+}
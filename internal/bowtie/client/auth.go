@@ -1,8 +1,9 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"errors"
 	"net/http"
 	"strings"
 )
@@ -23,32 +24,34 @@ type User struct {
 	Role              string `json:"role"`
 }
 
-func (c *Client) Login() error {
-	payload, err := json.Marshal(c.auth)
-	if err != nil {
-		return err
-	}
-
-	req, err := http.NewRequest("POST", c.getHostURL("/user/login"), strings.NewReader(string(payload)))
-	if err != nil {
-		return err
-	}
-	req.Header.Add("Content-Type", "application/json")
+// Login checks the client's credentials with the API, logging in if
+// they require a session.
+func (c *Client) Login(ctx context.Context) error {
+	return c.credentials.Login(ctx, c)
+}
 
-	res, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return err
+// isSessionExpired reports whether err means the controller no longer
+// accepts the credentials, typically an expired session cookie. API
+// routes answer with a 401, while routes shared with the web interface
+// redirect to the login page instead.
+func isSessionExpired(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
 	}
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusSeeOther {
-		return fmt.Errorf("failed to login: %s", res.Status)
+	switch apiErr.StatusCode {
+	case http.StatusUnauthorized:
+		return true
+	case http.StatusSeeOther, http.StatusFound, http.StatusTemporaryRedirect:
+		return strings.Contains(apiErr.location, "login")
 	}
 
-	return nil
+	return false
 }
 
-func (c *Client) WhoAmI() (*Me, error) {
-	req, err := http.NewRequest("GET", c.getHostURL("/user/me"), nil)
+func (c *Client) WhoAmI(ctx context.Context) (*Me, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.getHostURL("/user/me"), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,169 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// sessionServer is a controller stand-in that hands out a new session on
+// every login and can expire the current one on demand.
+type sessionServer struct {
+	mu       sync.Mutex
+	session  int
+	logins   int
+	requests int
+	// rejectLogins makes every login after the first fail.
+	rejectLogins bool
+	// expired controls how a request with a stale session is rejected.
+	expired func(w http.ResponseWriter, r *http.Request)
+}
+
+func (s *sessionServer) expire() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.session++
+}
+
+func (s *sessionServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.URL.Path == apiVersionPrefix+"/user/login" {
+		s.logins++
+		if s.rejectLogins && s.logins > 1 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		s.session++
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: fmt.Sprint(s.session), Path: "/"})
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	s.requests++
+	cookie, err := r.Cookie("session")
+	if err != nil || cookie.Value != fmt.Sprint(s.session) {
+		s.expired(w, r)
+		return
+	}
+
+	_, _ = w.Write([]byte(`{"id":"1"}`))
+}
+
+func unauthorized(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusUnauthorized)
+}
+
+func redirectToLogin(w http.ResponseWriter, r *http.Request) {
+	http.Redirect(w, r, "/login?next="+r.URL.Path, http.StatusSeeOther)
+}
+
+func newSessionTestClient(t *testing.T, s *sessionServer) (*Client, *httptest.Server) {
+	t.Helper()
+
+	server := httptest.NewServer(s)
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(context.Background(), server.URL, "test@example.com", "passw0rd123", false, WithRetryPolicy(testRetryPolicy()))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	return c, server
+}
+
+func TestClient_doRequest_reauthenticate(t *testing.T) {
+	tests := []struct {
+		name         string
+		expired      func(w http.ResponseWriter, r *http.Request)
+		rejectLogins bool
+		wantLogins   int
+		wantRequests int
+		wantErr      bool
+	}{
+		{
+			name:         "unauthorized",
+			expired:      unauthorized,
+			wantLogins:   2,
+			wantRequests: 2,
+		},
+		{
+			name:         "redirect to login",
+			expired:      redirectToLogin,
+			wantLogins:   2,
+			wantRequests: 2,
+		},
+		{
+			name:         "login rejected",
+			expired:      unauthorized,
+			rejectLogins: true,
+			wantLogins:   2,
+			wantRequests: 1,
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &sessionServer{expired: tt.expired, rejectLogins: tt.rejectLogins}
+			c, server := newSessionTestClient(t, s)
+			s.expire()
+
+			req, err := http.NewRequest(http.MethodGet, server.URL+apiVersionPrefix+"/policy", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			body, err := c.doRequest(req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Client.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && string(body) != `{"id":"1"}` {
+				t.Errorf("Client.doRequest() = %v, want the replayed response", string(body))
+			}
+			if s.logins != tt.wantLogins {
+				t.Errorf("logins = %d, want %d", s.logins, tt.wantLogins)
+			}
+			if s.requests != tt.wantRequests {
+				t.Errorf("requests = %d, want %d", s.requests, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestClient_doRequest_reauthenticateOnce(t *testing.T) {
+	s := &sessionServer{expired: unauthorized}
+	c, server := newSessionTestClient(t, s)
+	s.expire()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			req, err := http.NewRequest(http.MethodGet, server.URL+apiVersionPrefix+"/policy", nil)
+			if err != nil {
+				errs <- err
+				return
+			}
+			_, err = c.doRequest(req)
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("Client.doRequest() error = %v", err)
+		}
+	}
+	if s.logins != 2 {
+		t.Errorf("logins = %d, want 2", s.logins)
+	}
+}
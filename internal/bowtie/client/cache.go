@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// snapshotTTL bounds how long a cached snapshot is served. Writes made
+// through the client invalidate snapshots immediately, the TTL only
+// limits how long changes made outside of Terraform go unnoticed.
+const snapshotTTL = 30 * time.Second
+
+// snapshot caches the body of a whole-document endpoint such as
+// /organization or /policy, which the lookup helpers fetch to find a
+// single object. Concurrent fetches share a single request.
+//
+// The raw body is cached rather than the decoded document so that each
+// caller decodes its own copy and can't modify what other callers see.
+type snapshot struct {
+	mu         sync.Mutex
+	body       []byte
+	fetched    time.Time
+	generation uint64
+	inflight   *snapshotFetch
+}
+
+type snapshotFetch struct {
+	done chan struct{}
+	body []byte
+	err  error
+}
+
+// get returns the cached body, calling fetch if the snapshot is empty or
+// expired. Callers arriving while a fetch is running wait for its result.
+func (s *snapshot) get(ctx context.Context, fetch func(context.Context) ([]byte, error)) ([]byte, error) {
+	for {
+		s.mu.Lock()
+		if s.body != nil && time.Since(s.fetched) < snapshotTTL {
+			body := s.body
+			s.mu.Unlock()
+			return body, nil
+		}
+
+		if call := s.inflight; call != nil {
+			s.mu.Unlock()
+
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-call.done:
+			}
+
+			// The caller that started the fetch was cancelled, that's
+			// no reason to fail this one so fetch again.
+			if isContextError(call.err) && ctx.Err() == nil {
+				continue
+			}
+			return call.body, call.err
+		}
+
+		call := &snapshotFetch{done: make(chan struct{})}
+		s.inflight = call
+		generation := s.generation
+		s.mu.Unlock()
+
+		call.body, call.err = fetch(ctx)
+
+		s.mu.Lock()
+		if s.inflight == call {
+			s.inflight = nil
+		}
+		// Only keep the result if no write happened while it was being
+		// fetched, otherwise it may predate that write.
+		if call.err == nil && generation == s.generation {
+			s.body = call.body
+			s.fetched = time.Now()
+		}
+		s.mu.Unlock()
+		close(call.done)
+
+		return call.body, call.err
+	}
+}
+
+// invalidate drops the cached body. Fetches already in flight still
+// complete for their callers but are not cached, and later callers don't
+// join them.
+func (s *snapshot) invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.body = nil
+	s.inflight = nil
+	s.generation++
+}
+
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// invalidateSnapshots drops every cached snapshot. It's called after any
+// request that may have changed state on the controller.
+func (c *Client) invalidateSnapshots() {
+	c.organization.invalidate()
+	c.policies.invalidate()
+}
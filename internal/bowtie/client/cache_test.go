@@ -0,0 +1,132 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestClient_GetOrganization_cached(t *testing.T) {
+	var fetches int32
+	release := make(chan struct{})
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case apiVersionPrefix + "/organization":
+			if r.Method == http.MethodPost {
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+			atomic.AddInt32(&fetches, 1)
+			<-release
+			_, _ = w.Write([]byte(`{"id":"org","name":"Example","sites":[{"id":"site","name":"HQ"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.GetSite(ctx, "site"); err != nil {
+				t.Errorf("Client.GetSite() error = %v", err)
+			}
+		}()
+	}
+	close(release)
+	wg.Wait()
+
+	if _, err := c.ListSites(ctx); err != nil {
+		t.Fatalf("Client.ListSites() error = %v", err)
+	}
+	if got := atomic.LoadInt32(&fetches); got != 1 {
+		t.Errorf("fetches = %d, want 1", got)
+	}
+
+	if err := c.UpsertOrganization(ctx, "Renamed", "example.com", nil); err != nil {
+		t.Fatalf("Client.UpsertOrganization() error = %v", err)
+	}
+	if _, err := c.GetOrganization(ctx); err != nil {
+		t.Fatalf("Client.GetOrganization() error = %v", err)
+	}
+	if got := atomic.LoadInt32(&fetches); got != 2 {
+		t.Errorf("fetches after write = %d, want 2", got)
+	}
+}
+
+func TestClient_GetOrganization_cachedCopies(t *testing.T) {
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"org","sites":[{"id":"site","name":"HQ"}]}`))
+	})
+	ctx := context.Background()
+
+	first, err := c.GetOrganization(ctx)
+	if err != nil {
+		t.Fatalf("Client.GetOrganization() error = %v", err)
+	}
+	first.Sites[0].Name = "changed"
+
+	second, err := c.GetOrganization(ctx)
+	if err != nil {
+		t.Fatalf("Client.GetOrganization() error = %v", err)
+	}
+	if second.Sites[0].Name != "HQ" {
+		t.Errorf("cached site name = %v, want HQ", second.Sites[0].Name)
+	}
+}
+
+func TestSnapshot_get(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		var s snapshot
+		fetches := 0
+		fetch := func(context.Context) ([]byte, error) {
+			fetches++
+			if fetches == 1 {
+				return nil, errors.New("unavailable")
+			}
+			return []byte(`{}`), nil
+		}
+
+		if _, err := s.get(ctx, fetch); err == nil {
+			t.Fatal("snapshot.get() error = nil, want the fetch error")
+		}
+		if _, err := s.get(ctx, fetch); err != nil {
+			t.Fatalf("snapshot.get() error = %v", err)
+		}
+		if _, err := s.get(ctx, fetch); err != nil {
+			t.Fatalf("snapshot.get() error = %v", err)
+		}
+		if fetches != 2 {
+			t.Errorf("fetches = %d, want 2", fetches)
+		}
+	})
+
+	t.Run("fetch racing a write is not cached", func(t *testing.T) {
+		var s snapshot
+		fetches := 0
+		fetch := func(context.Context) ([]byte, error) {
+			fetches++
+			if fetches == 1 {
+				s.invalidate()
+			}
+			return []byte(`{}`), nil
+		}
+
+		if _, err := s.get(ctx, fetch); err != nil {
+			t.Fatalf("snapshot.get() error = %v", err)
+		}
+		if _, err := s.get(ctx, fetch); err != nil {
+			t.Fatalf("snapshot.get() error = %v", err)
+		}
+		if fetches != 2 {
+			t.Errorf("fetches = %d, want 2", fetches)
+		}
+	})
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"strings"
@@ -16,8 +17,9 @@ type Organization struct {
 }
 
 type OrganizationPayload struct {
-	Name   string `json:"name"`
-	Domain string `json:"domain"`
+	Name       string   `json:"name"`
+	Domain     string   `json:"domain"`
+	IPV6Ranges []string `json:"ipv6_ranges"`
 }
 
 type Site struct {
@@ -88,27 +90,36 @@ type Controller struct {
 	IPV6                string   `json:"ipv6"`
 }
 
-func (c *Client) GetOrganization() (*Organization, error) {
-	req, err := http.NewRequest(http.MethodGet, c.getHostURL("/organization"), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
+// GetOrganization returns the organization document, which describes
+// sites, DNS and most other settings. The document is cached briefly so
+// that looking up many objects in it doesn't download it every time.
+func (c *Client) GetOrganization(ctx context.Context) (*Organization, error) {
+	body, err := c.organization.get(ctx, func(ctx context.Context) ([]byte, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.getHostURL("/organization"), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return c.doRequest(req)
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	var org *Organization = &Organization{}
 	err = json.Unmarshal(body, org)
+	if err == nil && c.discoverControllers {
+		c.discoverHosts(ctx, org)
+	}
 
 	return org, err
 }
 
-func (c *Client) UpsertOrganization(name string, domain string) error {
+func (c *Client) UpsertOrganization(ctx context.Context, name string, domain string, ipv6Ranges []string) error {
 	payload := OrganizationPayload{
-		Name:   name,
-		Domain: domain,
+		Name:       name,
+		Domain:     domain,
+		IPV6Ranges: ipv6Ranges,
 	}
 
 	requestPayload, err := json.Marshal(payload)
@@ -116,7 +127,7 @@ func (c *Client) UpsertOrganization(name string, domain string) error {
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, c.getHostURL("/organization"), strings.NewReader(string(requestPayload)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.getHostURL("/organization"), strings.NewReader(string(requestPayload)))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,99 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// ControllerUpsertPayload carries the attributes of a controller that an
+// administrator configures. The rest, such as its public key and sync
+// state, are reported by the controller itself once it runs.
+type ControllerUpsertPayload struct {
+	ID                  string `json:"id"`
+	SiteID              string `json:"site_id"`
+	PublicAddress       string `json:"public_address"`
+	HTTPSEndpoint       string `json:"https_endpoint,omitempty"`
+	WireguardPort       int    `json:"wireguard_port,omitempty"`
+	PersistentKeepalive int    `json:"persistent_keepalive,omitempty"`
+}
+
+// ListControllers returns the controllers of every site. The organization
+// document nests controllers in their site, so the site ID is filled in
+// from it where the controller doesn't carry one.
+func (c *Client) ListControllers(ctx context.Context) ([]Controller, error) {
+	org, err := c.GetOrganization(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	controllers := []Controller{}
+	for _, site := range org.Sites {
+		for _, controller := range site.Controllers {
+			if controller.SiteID == "" {
+				controller.SiteID = site.ID
+			}
+			controllers = append(controllers, controller)
+		}
+	}
+
+	return controllers, nil
+}
+
+func (c *Client) GetController(ctx context.Context, id string) (*Controller, error) {
+	controllers, err := c.ListControllers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, controller := range controllers {
+		if controller.ID == id {
+			return &controller, nil
+		}
+	}
+
+	return nil, fmt.Errorf("controller %s: %w", id, ErrNotFound)
+}
+
+func (c *Client) CreateController(ctx context.Context, siteID, publicAddress, httpsEndpoint string, wireguardPort, persistentKeepalive int) (string, error) {
+	id := uuid.NewString()
+	return id, c.UpsertController(ctx, id, siteID, publicAddress, httpsEndpoint, wireguardPort, persistentKeepalive)
+}
+
+func (c *Client) UpsertController(ctx context.Context, id, siteID, publicAddress, httpsEndpoint string, wireguardPort, persistentKeepalive int) error {
+	payload := ControllerUpsertPayload{
+		ID:                  id,
+		SiteID:              siteID,
+		PublicAddress:       publicAddress,
+		HTTPSEndpoint:       httpsEndpoint,
+		WireguardPort:       wireguardPort,
+		PersistentKeepalive: persistentKeepalive,
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.getHostURL("/controller"), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
+
+func (c *Client) DeleteController(ctx context.Context, id string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.getHostURL(fmt.Sprintf("/controller/%s", id)), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
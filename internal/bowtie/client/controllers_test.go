@@ -0,0 +1,40 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestClient_ListControllers(t *testing.T) {
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"org","sites":[
+			{"id":"east","controllers":[{"id":"c1"},{"id":"c2","site_id":"east"}]},
+			{"id":"west","controllers":[{"id":"c3"}]},
+			{"id":"empty"}
+		]}`))
+	})
+	ctx := context.Background()
+
+	controllers, err := c.ListControllers(ctx)
+	if err != nil {
+		t.Fatalf("Client.ListControllers() error = %v", err)
+	}
+
+	got := map[string]string{}
+	for _, controller := range controllers {
+		got[controller.ID] = controller.SiteID
+	}
+	want := map[string]string{"c1": "east", "c2": "east", "c3": "west"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Client.ListControllers() sites = %v, want %v", got, want)
+	}
+
+	if _, err := c.GetController(ctx, "c3"); err != nil {
+		t.Errorf("Client.GetController() error = %v", err)
+	}
+	if _, err := c.GetController(ctx, "missing"); !IsNotFound(err) {
+		t.Errorf("Client.GetController() error = %v, want not found", err)
+	}
+}
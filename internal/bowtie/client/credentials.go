@@ -0,0 +1,200 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Credentials authenticate the requests a Client sends to the Bowtie API.
+type Credentials interface {
+	// Login checks the credentials with the API up-front, establishing
+	// a session first if the credentials need one.
+	Login(ctx context.Context, c *Client) error
+
+	// Authenticate prepares a request to be sent with the credentials.
+	// It returns the generation of the credentials in use, which is
+	// passed back to Refresh should the API reject the request.
+	Authenticate(ctx context.Context, c *Client, req *http.Request) (uint64, error)
+
+	// Refresh renews credentials the API rejected for a request. When
+	// several parallel requests are rejected at once, only the first
+	// needs to renew the credentials, so implementations should do
+	// nothing if they were renewed since the given generation. It
+	// returns false if the credentials can't be renewed.
+	Refresh(ctx context.Context, c *Client, req *http.Request, generation uint64) (bool, error)
+
+	// Secrets lists values that must never appear in logs.
+	Secrets() []string
+}
+
+// WithCredentials authenticates requests with the given credentials
+// instead of the username and password passed to NewClient.
+func WithCredentials(credentials Credentials) Option {
+	return func(c *Client) {
+		c.credentials = credentials
+	}
+}
+
+// PasswordCredentials log in with an administrator's email and password,
+// authenticating later requests with the session cookie that the login
+// returns. Each controller the client talks to has its own session.
+type PasswordCredentials struct {
+	AuthPayload
+
+	// Wrapped in a mutex lock to ensure that we don’t spam auth
+	// requests in the event of parallel resources being checked.
+	mu sync.Mutex
+	// sessions counts the logins to each controller, by host.
+	sessions map[string]uint64
+}
+
+func NewPasswordCredentials(username, password string) *PasswordCredentials {
+	return &PasswordCredentials{
+		AuthPayload: AuthPayload{
+			Username: username,
+			Password: password,
+		},
+	}
+}
+
+// Login logs in to the controller requests are currently sent to,
+// failing over to the next one while controllers are unavailable.
+func (p *PasswordCredentials) Login(ctx context.Context, c *Client) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	host := c.activeHost()
+	err := p.login(ctx, c, host)
+	for tried := 1; shouldFailover(err) && tried < c.hostCount(); tried++ {
+		failed, parseErr := url.Parse(host)
+		if parseErr != nil {
+			return parseErr
+		}
+		host = c.failover(ctx, failed, err)
+		err = p.login(ctx, c, host)
+	}
+	return err
+}
+
+// Check that the client has a login cookie, and if not, authenticate.
+func (p *PasswordCredentials) Authenticate(ctx context.Context, c *Client, req *http.Request) (uint64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(c.HTTPClient.Jar.Cookies(req.URL)) == 0 {
+		// Without any cookies for this URL, login first:
+		if err := p.login(ctx, c, origin(req.URL)); err != nil {
+			return 0, err
+		}
+	}
+
+	return p.sessions[req.URL.Host], nil
+}
+
+// Refresh logs in again after the controller expired or revoked the
+// session, unless another request already did so.
+func (p *PasswordCredentials) Refresh(ctx context.Context, c *Client, req *http.Request, generation uint64) (bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.sessions[req.URL.Host] != generation {
+		return true, nil
+	}
+
+	if err := p.login(ctx, c, origin(req.URL)); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (p *PasswordCredentials) Secrets() []string {
+	return []string{p.Password}
+}
+
+func (p *PasswordCredentials) login(ctx context.Context, c *Client, host string) error {
+	payload, err := json.Marshal(p.AuthPayload)
+	if err != nil {
+		return err
+	}
+
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	ctx, cancel := c.attemptContext(ctx)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, "POST", host+apiVersionPrefix+"/user/login", strings.NewReader(string(payload)))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Content-Type", "application/json")
+
+	tflog.SubsystemDebug(c.logContext(ctx), logSubsystem, "Logging in to Bowtie API", map[string]interface{}{
+		"username": p.Username,
+		"host":     host,
+	})
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	c.throttle(ctx, res)
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusSeeOther {
+		body, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("failed to login: %w", newAPIError(req, res, body))
+	}
+
+	if p.sessions == nil {
+		p.sessions = map[string]uint64{}
+	}
+	p.sessions[req.URL.Host]++
+
+	return nil
+}
+
+// TokenCredentials authenticate every request with an API token sent as
+// a bearer token, without logging in or keeping a session.
+type TokenCredentials struct {
+	Token string
+}
+
+func NewTokenCredentials(token string) *TokenCredentials {
+	return &TokenCredentials{Token: token}
+}
+
+// Login checks that the API accepts the token. The check is an ordinary
+// request, so it is retried and failed over like any other.
+func (t *TokenCredentials) Login(ctx context.Context, c *Client) error {
+	if _, err := c.WhoAmI(ctx); err != nil {
+		return fmt.Errorf("failed to authenticate with API token: %w", err)
+	}
+	return nil
+}
+
+func (t *TokenCredentials) Authenticate(ctx context.Context, c *Client, req *http.Request) (uint64, error) {
+	req.Header.Set("Authorization", "Bearer "+t.Token)
+	return 0, nil
+}
+
+// Refresh never succeeds since a rejected token can't be renewed.
+func (t *TokenCredentials) Refresh(ctx context.Context, c *Client, req *http.Request, generation uint64) (bool, error) {
+	return false, nil
+}
+
+func (t *TokenCredentials) Secrets() []string {
+	return []string{t.Token}
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTokenCredentials(t *testing.T) {
+	tests := []struct {
+		name         string
+		token        string
+		lazyAuth     bool
+		wantNewErr   bool
+		wantErr      bool
+		wantRequests int
+	}{
+		{
+			name:         "valid token",
+			token:        "valid",
+			lazyAuth:     true,
+			wantRequests: 1,
+		},
+		{
+			name:         "valid token checked up-front",
+			token:        "valid",
+			wantRequests: 2,
+		},
+		{
+			name:         "rejected token",
+			token:        "revoked",
+			lazyAuth:     true,
+			wantErr:      true,
+			wantRequests: 1,
+		},
+		{
+			name:         "rejected token checked up-front",
+			token:        "revoked",
+			wantNewErr:   true,
+			wantRequests: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if r.URL.Path == apiVersionPrefix+"/user/login" {
+					t.Errorf("unexpected login with API token")
+				}
+				if r.Header.Get("Authorization") != "Bearer valid" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				_, _ = w.Write([]byte(`{}`))
+			}))
+			t.Cleanup(server.Close)
+
+			c, err := NewClient(context.Background(), server.URL, "", "", tt.lazyAuth, WithCredentials(NewTokenCredentials(tt.token)), WithRetryPolicy(testRetryPolicy()))
+			if (err != nil) != tt.wantNewErr {
+				t.Fatalf("NewClient() error = %v, wantErr %v", err, tt.wantNewErr)
+			}
+
+			if err == nil {
+				_, err = c.GetOrganization(context.Background())
+				if (err != nil) != tt.wantErr {
+					t.Errorf("Client.GetOrganization() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if tt.wantErr && !IsUnauthorized(err) {
+					t.Errorf("Client.GetOrganization() error = %v, want unauthorized", err)
+				}
+			}
+
+			if requests != tt.wantRequests {
+				t.Errorf("requests = %d, want %d", requests, tt.wantRequests)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// DeviceGroup collects devices so that a policy can target all of them,
+// as user groups do for users.
+type DeviceGroup struct {
+	ID      string   `json:"id"`
+	Name    string   `json:"name"`
+	Devices []string `json:"devices,omitempty"`
+}
+
+type SetDeviceGroupMembershipPayload struct {
+	Devices []map[string]string `json:"devices"`
+}
+
+func (c *Client) GetDeviceGroup(ctx context.Context, id string) (*DeviceGroup, error) {
+	groups, err := c.ListDeviceGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	group, ok := groups[id]
+	if !ok {
+		return nil, fmt.Errorf("device group %s: %w", id, ErrNotFound)
+	}
+	return &group, nil
+}
+
+func (c *Client) GetDeviceGroupByName(ctx context.Context, name string) (*DeviceGroup, error) {
+	groups, err := c.ListDeviceGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return FindOne("device group", name, mapValues(groups), func(group DeviceGroup) bool {
+		return group.Name == name
+	})
+}
+
+func (c *Client) ListDeviceGroups(ctx context.Context) (map[string]DeviceGroup, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.getHostURL("/device_group"), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups map[string]DeviceGroup = map[string]DeviceGroup{}
+	err = json.Unmarshal(body, &groups)
+	return groups, err
+}
+
+func (c *Client) CreateDeviceGroup(ctx context.Context, name string) (string, error) {
+	return c.UpsertDeviceGroup(ctx, uuid.NewString(), name)
+}
+
+func (c *Client) UpsertDeviceGroup(ctx context.Context, id, name string) (string, error) {
+	payload := DeviceGroup{
+		ID:   id,
+		Name: name,
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.getHostURL("/device_group/upsert"), bytes.NewBuffer(body))
+	if err != nil {
+		return "", err
+	}
+
+	_, err = c.doRequest(req)
+	return id, err
+}
+
+func (c *Client) ListDevicesInDeviceGroup(ctx context.Context, id string) (*DeviceGroup, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.getHostURL(fmt.Sprintf("/device_group/%s/list", id)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var group *DeviceGroup = &DeviceGroup{}
+	err = json.Unmarshal(body, group)
+	if err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+func (c *Client) SetDeviceGroupMembership(ctx context.Context, groupID string, devices []string) error {
+	var deviceIDPayloads []map[string]string = []map[string]string{}
+	for _, deviceID := range devices {
+		deviceIDPayloads = append(deviceIDPayloads, map[string]string{
+			"id": deviceID,
+		})
+	}
+
+	payload := SetDeviceGroupMembershipPayload{
+		Devices: deviceIDPayloads,
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.getHostURL(fmt.Sprintf("/device_group/%s/set_membership", groupID)), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
+
+func (c *Client) DeleteDeviceGroup(ctx context.Context, id string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.getHostURL(fmt.Sprintf("/device_group/%s", id)), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
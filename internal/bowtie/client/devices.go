@@ -1,6 +1,8 @@
 package client
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -26,8 +28,82 @@ type Device struct {
 	LastSeenVersion string `json:"last_seen_version"`
 }
 
-func (c *Client) DeleteDevice(id string) error {
-	req, err := http.NewRequest(http.MethodDelete, c.getHostURL(fmt.Sprintf("/device/%s", id)), nil)
+// Device states an administrator can move a device to.
+const (
+	DeviceStatePending  = "Pending"
+	DeviceStateAccepted = "Accepted"
+	DeviceStateRejected = "Rejected"
+)
+
+// DeviceUpsertPayload carries the attributes of an enrolled device that
+// can be changed through the API. Devices enroll themselves, so there is
+// no way to create one.
+type DeviceUpsertPayload struct {
+	ID             string `json:"id"`
+	Name           string `json:"name,omitempty"`
+	AssignedToUser string `json:"assigned_to_user,omitempty"`
+	State          string `json:"state,omitempty"`
+}
+
+func (c *Client) GetDevice(ctx context.Context, id string) (*Device, error) {
+	devices, err := c.ListDevices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	device, ok := devices[id]
+	if !ok {
+		return nil, fmt.Errorf("device %s: %w", id, ErrNotFound)
+	}
+	return &device, nil
+}
+
+func (c *Client) GetDeviceBySerial(ctx context.Context, serial string) (*Device, error) {
+	devices, err := c.ListDevices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return FindOne("device with serial", serial, mapValues(devices), func(device Device) bool {
+		return device.Serial == serial
+	})
+}
+
+func (c *Client) GetDeviceByPublicKey(ctx context.Context, publicKey string) (*Device, error) {
+	devices, err := c.ListDevices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return FindOne("device with public key", publicKey, mapValues(devices), func(device Device) bool {
+		return device.PublicKey == publicKey
+	})
+}
+
+func (c *Client) UpsertDevice(ctx context.Context, id, name, assignedToUser, state string) error {
+	payload := DeviceUpsertPayload{
+		ID:             id,
+		Name:           name,
+		AssignedToUser: assignedToUser,
+		State:          state,
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.getHostURL("/device/upsert"), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
+
+func (c *Client) DeleteDevice(ctx context.Context, id string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.getHostURL(fmt.Sprintf("/device/%s", id)), nil)
 	if err != nil {
 		return err
 	}
@@ -36,8 +112,8 @@ func (c *Client) DeleteDevice(id string) error {
 	return err
 }
 
-func (c *Client) ListDevices() (map[string]Device, error) {
-	req, err := http.NewRequest(http.MethodGet, c.getHostURL("/device"), nil)
+func (c *Client) ListDevices(ctx context.Context) (map[string]Device, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.getHostURL("/device"), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -9,12 +10,12 @@ import (
 	"github.com/google/uuid"
 )
 
-func (c *Client) CreateDNS(name string, serverAddrs []Server, includeOnlySites []string, isDNS64, isCounted, isLog, isDropA, isDropAll, isSearchDomain bool, exlude []DNSExclude) (string, error) {
+func (c *Client) CreateDNS(ctx context.Context, name string, serverAddrs []Server, includeOnlySites []string, isDNS64, isCounted, isLog, isDropA, isDropAll, isSearchDomain bool, exlude []DNSExclude) (string, error) {
 	id := uuid.NewString()
-	return id, c.UpsertDNS(id, name, serverAddrs, includeOnlySites, isDNS64, isCounted, isLog, isDropA, isDropAll, isSearchDomain, exlude)
+	return id, c.UpsertDNS(ctx, id, name, serverAddrs, includeOnlySites, isDNS64, isCounted, isLog, isDropA, isDropAll, isSearchDomain, exlude)
 }
 
-func (c *Client) UpsertDNS(id, name string, serverAddrs []Server, includeOnlySites []string, isDNS64, isCounted, isLog, isDropA, isDropAll, isSearchDomain bool, exlude []DNSExclude) error {
+func (c *Client) UpsertDNS(ctx context.Context, id, name string, serverAddrs []Server, includeOnlySites []string, isDNS64, isCounted, isLog, isDropA, isDropAll, isSearchDomain bool, exlude []DNSExclude) error {
 	var servers map[string]Server = map[string]Server{}
 	for _, addr := range serverAddrs {
 		servers[addr.ID] = addr
@@ -43,7 +44,7 @@ func (c *Client) UpsertDNS(id, name string, serverAddrs []Server, includeOnlySit
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, c.getHostURL("/organization/dns/upsert"), bytes.NewBuffer(body))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.getHostURL("/organization/dns/upsert"), bytes.NewBuffer(body))
 	if err != nil {
 		return err
 	}
@@ -52,8 +53,8 @@ func (c *Client) UpsertDNS(id, name string, serverAddrs []Server, includeOnlySit
 	return err
 }
 
-func (c *Client) DeleteDNS(id string) error {
-	req, err := http.NewRequest(http.MethodDelete, c.getHostURL(fmt.Sprintf("/organization/dns/%s", id)), nil)
+func (c *Client) DeleteDNS(ctx context.Context, id string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.getHostURL(fmt.Sprintf("/organization/dns/%s", id)), nil)
 	if err != nil {
 		return err
 	}
@@ -62,16 +63,27 @@ func (c *Client) DeleteDNS(id string) error {
 	return err
 }
 
-func (c *Client) GetDNS(id string) (*DNS, error) {
-	org, err := c.GetOrganization()
+func (c *Client) GetDNS(ctx context.Context, id string) (*DNS, error) {
+	org, err := c.GetOrganization(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	result, ok := org.DNS[id]
 	if !ok {
-		return nil, fmt.Errorf("failed to locate the dns object")
+		return nil, fmt.Errorf("dns %s: %w", id, ErrNotFound)
 	}
 
 	return &result, nil
 }
+
+func (c *Client) GetDNSByName(ctx context.Context, name string) (*DNS, error) {
+	org, err := c.GetOrganization(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return FindOne("dns", name, mapValues(org.DNS), func(dns DNS) bool {
+		return dns.Name == name
+	})
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -9,12 +10,12 @@ import (
 	"github.com/google/uuid"
 )
 
-func (c *Client) CreateDNSBlockList(name string, upstream string, override_to_allow string) (string, error) {
+func (c *Client) CreateDNSBlockList(ctx context.Context, name string, upstream string, override_to_allow string) (string, error) {
 	id := uuid.NewString()
-	return id, c.UpsertDNSBlockList(id, name, upstream, override_to_allow)
+	return id, c.UpsertDNSBlockList(ctx, id, name, upstream, override_to_allow)
 }
 
-func (c *Client) UpsertDNSBlockList(id string, name string, upstream string, override_to_allow string) error {
+func (c *Client) UpsertDNSBlockList(ctx context.Context, id string, name string, upstream string, override_to_allow string) error {
 	var payload DNSBlockList = DNSBlockList{
 		ID:              id,
 		Name:            name,
@@ -27,7 +28,7 @@ func (c *Client) UpsertDNSBlockList(id string, name string, upstream string, ove
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, c.getHostURL("/dns_block_list"), bytes.NewBuffer(body))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.getHostURL("/dns_block_list"), bytes.NewBuffer(body))
 	if err != nil {
 		return err
 	}
@@ -36,8 +37,8 @@ func (c *Client) UpsertDNSBlockList(id string, name string, upstream string, ove
 	return err
 }
 
-func (c *Client) DeleteDNSBlockList(id string) error {
-	req, err := http.NewRequest(http.MethodDelete, c.getHostURL(fmt.Sprintf("/dns_block_list/%s", id)), nil)
+func (c *Client) DeleteDNSBlockList(ctx context.Context, id string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.getHostURL(fmt.Sprintf("/dns_block_list/%s", id)), nil)
 	if err != nil {
 		return err
 	}
@@ -46,8 +47,8 @@ func (c *Client) DeleteDNSBlockList(id string) error {
 	return err
 }
 
-func (c *Client) GetDNSBlockLists() (map[string]DNSBlockList, error) {
-	req, err := http.NewRequest(http.MethodGet, c.getHostURL("/dns_block_list"), nil)
+func (c *Client) GetDNSBlockLists(ctx context.Context) (map[string]DNSBlockList, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.getHostURL("/dns_block_list"), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -62,8 +63,8 @@ func (c *Client) GetDNSBlockLists() (map[string]DNSBlockList, error) {
 	return dnsblocklists, err
 }
 
-func (c *Client) GetDNSBlockList(id string) (*DNSBlockList, error) {
-	blocklists, err := c.GetDNSBlockLists()
+func (c *Client) GetDNSBlockList(ctx context.Context, id string) (*DNSBlockList, error) {
+	blocklists, err := c.GetDNSBlockLists(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -74,5 +75,16 @@ func (c *Client) GetDNSBlockList(id string) (*DNSBlockList, error) {
 		}
 	}
 
-	return nil, fmt.Errorf("block list not found: %s", id)
+	return nil, fmt.Errorf("block list %s: %w", id, ErrNotFound)
+}
+
+func (c *Client) GetDNSBlockListByName(ctx context.Context, name string) (*DNSBlockList, error) {
+	blocklists, err := c.GetDNSBlockLists(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return FindOne("block list", name, mapValues(blocklists), func(blocklist DNSBlockList) bool {
+		return blocklist.Name == name
+	})
 }
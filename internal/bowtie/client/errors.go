@@ -0,0 +1,141 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// maxErrorMessageLength bounds how much of a non-JSON response body is
+// carried in an APIError so that HTML error pages don't flood diagnostics.
+const maxErrorMessageLength = 512
+
+// ErrNotFound is wrapped by lookup helpers that search a listing for a
+// single object and fail to find it.
+var ErrNotFound = errors.New("not found")
+
+// ErrAmbiguous is wrapped by lookup helpers that search a listing for a
+// single object and find more than one.
+var ErrAmbiguous = errors.New("expected exactly one match")
+
+// FindOne returns the only item that match accepts. The error wraps
+// ErrNotFound when nothing matches and ErrAmbiguous when several items do,
+// and is prefixed with what and key, e.g. "site Office: not found".
+func FindOne[T any](what, key string, items []T, match func(T) bool) (*T, error) {
+	var matches []T
+	for _, item := range items {
+		if match(item) {
+			matches = append(matches, item)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("%s %s: %w", what, key, ErrNotFound)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("%s %s: %w, found %d", what, key, ErrAmbiguous, len(matches))
+	}
+}
+
+// mapValues lets FindOne search the ID-keyed maps returned by listings.
+func mapValues[K comparable, V any](m map[K]V) []V {
+	values := make([]V, 0, len(m))
+	for _, v := range m {
+		values = append(values, v)
+	}
+	return values
+}
+
+// APIError is returned for any response from the Bowtie API with a status
+// code outside of the 2xx range.
+type APIError struct {
+	StatusCode int
+	Method     string
+	Path       string
+	Message    string
+
+	// location is the redirect target of a 3xx response.
+	location string
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("%s %s returned %d %s", e.Method, e.Path, e.StatusCode, http.StatusText(e.StatusCode))
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	return msg
+}
+
+func newAPIError(req *http.Request, res *http.Response, body []byte) *APIError {
+	return &APIError{
+		StatusCode: res.StatusCode,
+		Method:     req.Method,
+		Path:       req.URL.Path,
+		Message:    errorMessage(body),
+		location:   res.Header.Get("Location"),
+	}
+}
+
+// errorMessage extracts a human readable message from an error response
+// body. The controller usually responds with a JSON object carrying a
+// message or error key, but proxies in front of it may return plain text
+// or HTML instead.
+func errorMessage(body []byte) string {
+	var payload struct {
+		Message string `json:"message"`
+		Error   string `json:"error"`
+	}
+	if err := json.Unmarshal(body, &payload); err == nil {
+		if payload.Message != "" {
+			return payload.Message
+		}
+		if payload.Error != "" {
+			return payload.Error
+		}
+	}
+
+	msg := strings.TrimSpace(string(body))
+	if len(msg) > maxErrorMessageLength {
+		msg = msg[:maxErrorMessageLength] + "..."
+	}
+	return msg
+}
+
+// statusCode returns the HTTP status code carried by err, or 0 if err is
+// not an APIError.
+func statusCode(err error) int {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
+
+// IsNotFound reports whether err was caused by the requested object not
+// existing, either because the API responded with a 404 or because a
+// lookup helper could not find it.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound) || statusCode(err) == http.StatusNotFound
+}
+
+// IsUnauthorized reports whether err was caused by missing or expired
+// credentials.
+func IsUnauthorized(err error) bool {
+	return statusCode(err) == http.StatusUnauthorized
+}
+
+// IsForbidden reports whether err was caused by the authenticated user
+// lacking permission for the request.
+func IsForbidden(err error) bool {
+	return statusCode(err) == http.StatusForbidden
+}
+
+// IsServerError reports whether err was caused by the API failing to
+// process an otherwise valid request.
+func IsServerError(err error) bool {
+	return statusCode(err) >= http.StatusInternalServerError
+}
@@ -0,0 +1,135 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestAPIError_Error(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *APIError
+		want string
+	}{
+		{
+			name: "with message",
+			err: &APIError{
+				StatusCode: http.StatusNotFound,
+				Method:     http.MethodDelete,
+				Path:       "/-net/api/v0/policy/123",
+				Message:    "no such policy",
+			},
+			want: "DELETE /-net/api/v0/policy/123 returned 404 Not Found: no such policy",
+		},
+		{
+			name: "without message",
+			err: &APIError{
+				StatusCode: http.StatusBadGateway,
+				Method:     http.MethodGet,
+				Path:       "/-net/api/v0/organization",
+			},
+			want: "GET /-net/api/v0/organization returned 502 Bad Gateway",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.want {
+				t.Errorf("APIError.Error() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_errorMessage(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{name: "message", body: `{"message":"bad request"}`, want: "bad request"},
+		{name: "error", body: `{"error":"database unavailable"}`, want: "database unavailable"},
+		{name: "plain text", body: "  upstream timed out\n", want: "upstream timed out"},
+		{name: "empty", body: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errorMessage([]byte(tt.body)); got != tt.want {
+				t.Errorf("errorMessage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	notFound := &APIError{StatusCode: http.StatusNotFound}
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "not found", err: notFound, want: true},
+		{name: "wrapped", err: fmt.Errorf("reading policy: %w", notFound), want: true},
+		{name: "lookup", err: fmt.Errorf("site 123: %w", ErrNotFound), want: true},
+		{name: "other status", err: &APIError{StatusCode: http.StatusInternalServerError}, want: false},
+		{name: "other error", err: fmt.Errorf("connection refused"), want: false},
+		{name: "nil", err: nil, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNotFound(tt.err); got != tt.want {
+				t.Errorf("IsNotFound() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsUnauthorized(t *testing.T) {
+	if !IsUnauthorized(&APIError{StatusCode: http.StatusUnauthorized}) {
+		t.Errorf("IsUnauthorized() = false, want true")
+	}
+	if IsUnauthorized(&APIError{StatusCode: http.StatusForbidden}) {
+		t.Errorf("IsUnauthorized() = true for 403, want false")
+	}
+	if !IsForbidden(&APIError{StatusCode: http.StatusForbidden}) {
+		t.Errorf("IsForbidden() = false, want true")
+	}
+	if !IsServerError(&APIError{StatusCode: http.StatusServiceUnavailable}) {
+		t.Errorf("IsServerError() = false, want true")
+	}
+}
+
+func TestFindOne(t *testing.T) {
+	sites := []Site{{ID: "1", Name: "Office"}, {ID: "2", Name: "Lab"}, {ID: "3", Name: "Lab"}}
+	tests := []struct {
+		name    string
+		key     string
+		wantID  string
+		wantErr error
+		wantMsg string
+	}{
+		{name: "one match", key: "Office", wantID: "1"},
+		{name: "no match", key: "Home", wantErr: ErrNotFound, wantMsg: "site Home: not found"},
+		{name: "several matches", key: "Lab", wantErr: ErrAmbiguous, wantMsg: "site Lab: expected exactly one match, found 2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FindOne("site", tt.key, sites, func(site Site) bool {
+				return site.Name == tt.key
+			})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) || err.Error() != tt.wantMsg {
+					t.Errorf("FindOne() error = %v, want %q", err, tt.wantMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FindOne() error = %v", err)
+			}
+			if got.ID != tt.wantID {
+				t.Errorf("FindOne() = %v, want %v", got.ID, tt.wantID)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -29,21 +30,32 @@ type SetUserGroupMembershipPayload struct {
 	Users []map[string]string `json:"users"`
 }
 
-func (c *Client) GetGroup(id string) (*Group, error) {
-	groups, err := c.ListGroups()
+func (c *Client) GetGroup(ctx context.Context, id string) (*Group, error) {
+	groups, err := c.ListGroups(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	group, ok := groups[id]
 	if !ok {
-		return nil, fmt.Errorf("failed to find group with id: %s", id)
+		return nil, fmt.Errorf("group %s: %w", id, ErrNotFound)
 	}
 	return &group, nil
 }
 
-func (c *Client) ListGroups() (map[string]Group, error) {
-	req, err := http.NewRequest("GET", c.getHostURL("/group"), nil)
+func (c *Client) GetGroupByName(ctx context.Context, name string) (*Group, error) {
+	groups, err := c.ListGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return FindOne("group", name, mapValues(groups), func(group Group) bool {
+		return group.Name == name
+	})
+}
+
+func (c *Client) ListGroups(ctx context.Context) (map[string]Group, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.getHostURL("/group"), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -62,11 +74,11 @@ func (c *Client) ListGroups() (map[string]Group, error) {
 	return groups, nil
 }
 
-func (c *Client) CreateGroup(name string) (string, error) {
-	return c.UpsertGroup(uuid.NewString(), name)
+func (c *Client) CreateGroup(ctx context.Context, name string) (string, error) {
+	return c.UpsertGroup(ctx, uuid.NewString(), name)
 }
 
-func (c *Client) UpsertGroup(id, name string) (string, error) {
+func (c *Client) UpsertGroup(ctx context.Context, id, name string) (string, error) {
 	groupRequest := Group{
 		Name: name,
 		ID:   id,
@@ -77,7 +89,7 @@ func (c *Client) UpsertGroup(id, name string) (string, error) {
 		return "", err
 	}
 
-	req, err := http.NewRequest("POST", c.getHostURL("/group/upsert"), strings.NewReader(string(requestBody)))
+	req, err := http.NewRequestWithContext(ctx, "POST", c.getHostURL("/group/upsert"), strings.NewReader(string(requestBody)))
 	if err != nil {
 		return "", err
 	}
@@ -96,8 +108,8 @@ func (c *Client) UpsertGroup(id, name string) (string, error) {
 	return id, nil
 }
 
-func (c *Client) ListUsersInGroup(id string) (*Group, error) {
-	req, err := http.NewRequest("GET", c.getHostURL(fmt.Sprintf("/group/%s/list", id)), nil)
+func (c *Client) ListUsersInGroup(ctx context.Context, id string) (*Group, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.getHostURL(fmt.Sprintf("/group/%s/list", id)), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -116,15 +128,15 @@ func (c *Client) ListUsersInGroup(id string) (*Group, error) {
 	return group, nil
 }
 
-func (c *Client) AddUserToGroup(groupID string, userIDs []string) (*ModifyUserGroupResponse, error) {
-	return c.modifyUserGroup("addusers", groupID, userIDs)
+func (c *Client) AddUserToGroup(ctx context.Context, groupID string, userIDs []string) (*ModifyUserGroupResponse, error) {
+	return c.modifyUserGroup(ctx, "addusers", groupID, userIDs)
 }
 
-func (c *Client) RemoveUserFromGroup(groupID string, userIDs []string) (*ModifyUserGroupResponse, error) {
-	return c.modifyUserGroup("removeusers", groupID, userIDs)
+func (c *Client) RemoveUserFromGroup(ctx context.Context, groupID string, userIDs []string) (*ModifyUserGroupResponse, error) {
+	return c.modifyUserGroup(ctx, "removeusers", groupID, userIDs)
 }
 
-func (c *Client) modifyUserGroup(action, groupID string, userIDs []string) (*ModifyUserGroupResponse, error) {
+func (c *Client) modifyUserGroup(ctx context.Context, action, groupID string, userIDs []string) (*ModifyUserGroupResponse, error) {
 	var userIDPayloads []map[string]string = []map[string]string{}
 	for _, userId := range userIDs {
 		userIDPayloads = append(userIDPayloads, map[string]string{
@@ -139,7 +151,7 @@ func (c *Client) modifyUserGroup(action, groupID string, userIDs []string) (*Mod
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", c.getHostURL(fmt.Sprintf("/group/%s", action)), strings.NewReader(string(payload)))
+	req, err := http.NewRequestWithContext(ctx, "POST", c.getHostURL(fmt.Sprintf("/group/%s", action)), strings.NewReader(string(payload)))
 	if err != nil {
 		return nil, err
 	}
@@ -155,8 +167,8 @@ func (c *Client) modifyUserGroup(action, groupID string, userIDs []string) (*Mod
 	return response, err
 }
 
-func (c *Client) DeleteGroup(groupID string) error {
-	req, err := http.NewRequest(http.MethodDelete, c.getHostURL(fmt.Sprintf("/group/%s", groupID)), nil)
+func (c *Client) DeleteGroup(ctx context.Context, groupID string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.getHostURL(fmt.Sprintf("/group/%s", groupID)), nil)
 	if err != nil {
 		return err
 	}
@@ -165,7 +177,7 @@ func (c *Client) DeleteGroup(groupID string) error {
 	return err
 }
 
-func (c *Client) SetGroupMembership(groupID string, users []string) error {
+func (c *Client) SetGroupMembership(ctx context.Context, groupID string, users []string) error {
 	var userIDPayloads []map[string]string = []map[string]string{}
 	for _, userId := range users {
 		userIDPayloads = append(userIDPayloads, map[string]string{
@@ -182,7 +194,7 @@ func (c *Client) SetGroupMembership(groupID string, users []string) error {
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, c.getHostURL(fmt.Sprintf("/group/%s/set_membership", groupID)), bytes.NewBuffer(body))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.getHostURL(fmt.Sprintf("/group/%s/set_membership", groupID)), bytes.NewBuffer(body))
 	if err != nil {
 		return err
 	}
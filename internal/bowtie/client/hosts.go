@@ -0,0 +1,159 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// WithFailoverHosts adds controllers that the client fails over to, in
+// order, when the host passed to NewClient is unreachable or responds
+// with a server error. Any controller of an organization can serve the
+// API.
+func WithFailoverHosts(hosts ...string) Option {
+	return func(c *Client) {
+		c.addHosts(hosts...)
+	}
+}
+
+// WithControllerDiscovery adds the HTTPS endpoint of every controller
+// listed in the organization to the failover hosts whenever the
+// organization is read, so controllers added later are picked up too.
+// Hosts that are already known are left where they are.
+func WithControllerDiscovery() Option {
+	return func(c *Client) {
+		c.discoverControllers = true
+	}
+}
+
+// activeHost returns the host requests are currently sent to.
+func (c *Client) activeHost() string {
+	c.hostsMu.Lock()
+	defer c.hostsMu.Unlock()
+
+	if len(c.hosts) == 0 {
+		return c.hostURL
+	}
+	return c.hosts[c.active]
+}
+
+func (c *Client) hostCount() int {
+	c.hostsMu.Lock()
+	defer c.hostsMu.Unlock()
+
+	return len(c.hosts)
+}
+
+// addHosts appends hosts not known yet to the failover order.
+func (c *Client) addHosts(hosts ...string) []string {
+	c.hostsMu.Lock()
+	defer c.hostsMu.Unlock()
+
+	if len(c.hosts) == 0 && c.hostURL != "" {
+		c.hosts = []string{c.hostURL}
+	}
+
+	added := []string{}
+	for _, host := range hosts {
+		host = strings.TrimSuffix(host, "/")
+		if host == "" {
+			continue
+		}
+
+		known := false
+		for _, existing := range c.hosts {
+			if existing == host {
+				known = true
+				break
+			}
+		}
+		if !known {
+			c.hosts = append(c.hosts, host)
+			added = append(added, host)
+		}
+	}
+
+	return added
+}
+
+// failover moves away from the host a request failed against and returns
+// the host to try next. If another request already failed over, the
+// host it moved to is returned instead of skipping ahead again.
+func (c *Client) failover(ctx context.Context, failed *url.URL, err error) string {
+	c.hostsMu.Lock()
+	defer c.hostsMu.Unlock()
+
+	if hostOf(c.hosts[c.active]) == failed.Host {
+		c.active = (c.active + 1) % len(c.hosts)
+		tflog.SubsystemWarn(ctx, logSubsystem, "Bowtie controller unavailable, failing over to the next controller", map[string]interface{}{
+			"failed_host": failed.Host,
+			"next_host":   c.hosts[c.active],
+			"error":       err.Error(),
+		})
+	}
+
+	return c.hosts[c.active]
+}
+
+// shouldFailover reports whether err suggests the controller itself is
+// unhealthy, rather than the request being wrong.
+func shouldFailover(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= http.StatusInternalServerError
+	}
+
+	return isTransientError(err)
+}
+
+// retarget points a request at another host, keeping its path.
+func retarget(req *http.Request, host string) error {
+	target, err := url.Parse(host)
+	if err != nil {
+		return err
+	}
+
+	req.URL.Scheme = target.Scheme
+	req.URL.Host = target.Host
+	req.Host = target.Host
+
+	return nil
+}
+
+// discoverHosts adds the controllers of an organization to the failover
+// hosts.
+func (c *Client) discoverHosts(ctx context.Context, org *Organization) {
+	endpoints := []string{}
+	for _, site := range org.Sites {
+		for _, controller := range site.Controllers {
+			endpoints = append(endpoints, controller.HTTPSEndpoint)
+		}
+	}
+
+	if added := c.addHosts(endpoints...); len(added) > 0 {
+		tflog.SubsystemDebug(ctx, logSubsystem, "Discovered Bowtie controllers", map[string]interface{}{
+			"hosts": added,
+		})
+	}
+}
+
+func hostOf(host string) string {
+	u, err := url.Parse(host)
+	if err != nil {
+		return host
+	}
+	return u.Host
+}
+
+// origin returns the scheme and host of a URL, the form hosts are kept in.
+func origin(u *url.URL) string {
+	return u.Scheme + "://" + u.Host
+}
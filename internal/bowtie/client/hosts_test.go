@@ -0,0 +1,204 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// controllerServer is a controller stand-in that counts the logins and
+// requests it serves and can be made unhealthy.
+type controllerServer struct {
+	*httptest.Server
+
+	// URL addresses the server as localhost rather than 127.0.0.1 when
+	// requested, cookies don't tell apart servers on different ports
+	// of the same host.
+	URL string
+
+	mu        sync.Mutex
+	logins    int
+	requests  int
+	unhealthy bool
+	org       string
+}
+
+func newControllerServer(t *testing.T, localhost bool) *controllerServer {
+	t.Helper()
+
+	s := &controllerServer{org: `{}`}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if s.unhealthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		if r.URL.Path == apiVersionPrefix+"/user/login" {
+			s.logins++
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "valid", Path: "/"})
+			return
+		}
+
+		s.requests++
+		if _, err := r.Cookie("session"); err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(s.org))
+	}))
+	t.Cleanup(s.Close)
+
+	s.URL = s.Server.URL
+	if localhost {
+		s.URL = strings.Replace(s.URL, "127.0.0.1", "localhost", 1)
+	}
+
+	return s
+}
+
+func (s *controllerServer) setUnhealthy(unhealthy bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.unhealthy = unhealthy
+}
+
+func (s *controllerServer) counts() (int, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.logins, s.requests
+}
+
+func TestClient_failover(t *testing.T) {
+	primary := newControllerServer(t, false)
+	secondary := newControllerServer(t, true)
+
+	retry := testRetryPolicy()
+	retry.MaxAttempts = 1
+	c, err := NewClient(context.Background(), primary.URL, "test@example.com", "passw0rd123", true, WithFailoverHosts(secondary.URL), WithRetryPolicy(retry))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	get := func() error {
+		req, err := http.NewRequest(http.MethodGet, c.getHostURL("/policy"), nil)
+		if err != nil {
+			return err
+		}
+		_, err = c.doRequest(req)
+		return err
+	}
+
+	if err := get(); err != nil {
+		t.Fatalf("Client.doRequest() error = %v", err)
+	}
+
+	primary.setUnhealthy(true)
+	for i := 0; i < 2; i++ {
+		if err := get(); err != nil {
+			t.Fatalf("Client.doRequest() error = %v", err)
+		}
+	}
+	if c.activeHost() != secondary.URL {
+		t.Errorf("active host = %v, want %v", c.activeHost(), secondary.URL)
+	}
+
+	logins, requests := secondary.counts()
+	if logins != 1 || requests != 2 {
+		t.Errorf("secondary logins, requests = %d, %d, want 1, 2", logins, requests)
+	}
+
+	secondary.setUnhealthy(true)
+	primary.setUnhealthy(false)
+	if err := get(); err != nil {
+		t.Fatalf("Client.doRequest() error = %v", err)
+	}
+
+	// The primary session is still valid, no need to log in again.
+	logins, requests = primary.counts()
+	if logins != 1 || requests != 2 {
+		t.Errorf("primary logins, requests = %d, %d, want 1, 2", logins, requests)
+	}
+
+	primary.setUnhealthy(true)
+	if err := get(); !IsServerError(err) {
+		t.Errorf("Client.doRequest() error = %v, want a server error once every controller is down", err)
+	}
+}
+
+func TestClient_failoverLogin(t *testing.T) {
+	primary := newControllerServer(t, false)
+	secondary := newControllerServer(t, true)
+	primary.setUnhealthy(true)
+
+	c, err := NewClient(context.Background(), primary.URL, "test@example.com", "passw0rd123", false, WithFailoverHosts(secondary.URL), WithRetryPolicy(testRetryPolicy()))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if c.activeHost() != secondary.URL {
+		t.Errorf("active host = %v, want %v", c.activeHost(), secondary.URL)
+	}
+}
+
+// Token credentials check the token with an ordinary request, which
+// fails over by itself, so logging in must not fail over again on top.
+func TestClient_failoverLoginToken(t *testing.T) {
+	var mu sync.Mutex
+	requests := 0
+	hosts := []string{}
+	for i := 0; i < 3; i++ {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			requests++
+			mu.Unlock()
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		t.Cleanup(server.Close)
+		hosts = append(hosts, server.URL)
+	}
+
+	retry := testRetryPolicy()
+	retry.MaxAttempts = 2
+	_, err := NewClient(context.Background(), hosts[0], "", "", false, WithFailoverHosts(hosts[1:]...), WithRetryPolicy(retry), WithCredentials(NewTokenCredentials("token")))
+	if !IsServerError(err) {
+		t.Fatalf("NewClient() error = %v, want a server error", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if want := len(hosts) * retry.MaxAttempts; requests != want {
+		t.Errorf("requests = %d, want %d", requests, want)
+	}
+}
+
+func TestClient_discoverControllers(t *testing.T) {
+	primary := newControllerServer(t, false)
+	discovered := newControllerServer(t, true)
+	primary.org = fmt.Sprintf(`{"sites":[{"id":"site","controllers":[{"id":"a","https_endpoint":"%s"},{"id":"b","https_endpoint":"%s/"}]}]}`, primary.URL, discovered.URL)
+
+	c, err := NewClient(context.Background(), primary.URL, "test@example.com", "passw0rd123", true, WithControllerDiscovery(), WithRetryPolicy(testRetryPolicy()))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	if _, err := c.GetOrganization(context.Background()); err != nil {
+		t.Fatalf("Client.GetOrganization() error = %v", err)
+	}
+	if got := c.hostCount(); got != 2 {
+		t.Fatalf("hosts = %d, want 2", got)
+	}
+
+	primary.setUnhealthy(true)
+	if _, err := c.ListGroups(context.Background()); err != nil {
+		t.Fatalf("Client.ListGroups() error = %v", err)
+	}
+	if c.activeHost() != discovered.URL {
+		t.Errorf("active host = %v, want %v", c.activeHost(), discovered.URL)
+	}
+}
@@ -9,14 +9,57 @@ import (
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type Client struct {
 	HTTPClient *http.Client
 
-	hostURL   string
-	auth      AuthPayload
-	authCheck sync.Mutex
+	hostURL     string
+	credentials Credentials
+	retry       RetryPolicy
+	// requestTimeout bounds each attempt of a request.
+	requestTimeout time.Duration
+
+	// hosts lists every controller the client may send requests to, in
+	// failover order, starting with hostURL. active indexes the one
+	// currently in use.
+	hosts               []string
+	active              int
+	hostsMu             sync.Mutex
+	discoverControllers bool
+
+	limiter rateLimiter
+	// inFlight holds a slot for every request being sent when the
+	// number of concurrent requests is limited.
+	inFlight chan struct{}
+
+	organization snapshot
+	policies     snapshot
+}
+
+// Option customizes a Client created by NewClient.
+type Option func(*Client)
+
+// WithRetryPolicy overrides the default policy used to retry requests
+// that fail for transient reasons.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = policy
+	}
+}
+
+// WithRequestTimeout overrides how long a single attempt of a request may
+// take. A deadline on the caller's context, as for resources with a
+// timeouts block, only ever shortens an attempt, so that a hanging
+// attempt leaves time to retry. Requests the API is slow to answer can
+// be given longer with ContextWithRequestTimeout. Zero disables the
+// timeout.
+func WithRequestTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.requestTimeout = timeout
+	}
 }
 
 type AuthPayload struct {
@@ -26,28 +69,33 @@ type AuthPayload struct {
 
 const apiVersionPrefix = "/-net/api/v0"
 
-func NewClient(ctx context.Context, host, username, password string, lazy_auth bool) (*Client, error) {
+const defaultRequestTimeout = 10 * time.Second
+
+func NewClient(ctx context.Context, host, username, password string, lazy_auth bool, opts ...Option) (*Client, error) {
 	jar, err := cookiejar.New(nil)
 	if err != nil {
 		return nil, err
 	}
 	c := &Client{
 		HTTPClient: &http.Client{
-			Timeout: 10 * time.Second,
-			Jar:     jar,
+			Jar: jar,
 			CheckRedirect: func(req *http.Request, via []*http.Request) error {
 				return http.ErrUseLastResponse
 			},
 		},
-		hostURL: host,
-		auth: AuthPayload{
-			Username: username,
-			Password: password,
-		},
+		hostURL:        host,
+		credentials:    NewPasswordCredentials(username, password),
+		retry:          DefaultRetryPolicy(),
+		requestTimeout: defaultRequestTimeout,
 	}
 
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.addHosts()
+
 	if !lazy_auth {
-		if err := c.Login(); err != nil {
+		if err := c.Login(ctx); err != nil {
 			return nil, err
 		}
 	}
@@ -55,49 +103,187 @@ func NewClient(ctx context.Context, host, username, password string, lazy_auth b
 	return c, nil
 }
 
-// Check that the client has a login cookie, and if not, authenticate
-func (c *Client) ensureAuth(req *http.Request) error {
-	// Wrapped in a mutex lock to ensure that we don’t spam auth
-	// requests in the event of parallel resources being checked.
-	c.authCheck.Lock()
-	defer c.authCheck.Unlock()
-
-	if len(c.HTTPClient.Jar.Cookies(req.URL)) == 0 {
-		// Without any cookies for this URL, login first:
-		if err := c.Login(); err != nil {
-			return err
+func (c *Client) doRequest(req *http.Request) ([]byte, error) {
+	req = req.WithContext(c.logContext(req.Context()))
+
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		// Any write may change the cached organization or policy
+		// documents, including one that failed part way through.
+		defer c.invalidateSnapshots()
+	}
+
+	if req.Method == http.MethodPost {
+		req.Header.Add("Content-Type", "application/json")
+	}
+
+	ctx := req.Context()
+	attempt := 0
+	tried := 1
+	body, err := c.sendAuthenticated(req, attempt)
+	for {
+		// Try every other controller before backing off, the request
+		// may well succeed on one that is healthy.
+		if shouldFailover(err) && tried < c.hostCount() {
+			tried++
+			host := c.failover(ctx, req.URL, err)
+			if req, err = rewindRequest(req); err != nil {
+				return nil, err
+			}
+			if err := retarget(req, host); err != nil {
+				return nil, err
+			}
+			body, err = c.sendAuthenticated(req, attempt)
+			continue
+		}
+
+		if !c.retry.shouldRetry(ctx, attempt, err) {
+			break
 		}
+
+		attempt++
+		tried = 1
+		tflog.SubsystemDebug(ctx, logSubsystem, "Retrying Bowtie API request", map[string]interface{}{
+			"method":       req.Method,
+			"path":         req.URL.Path,
+			"retry":        attempt,
+			"max_attempts": c.retry.MaxAttempts,
+			"error":        err.Error(),
+		})
+		if err := c.retry.wait(ctx, attempt); err != nil {
+			return nil, err
+		}
+
+		if req, err = rewindRequest(req); err != nil {
+			return nil, err
+		}
+		if err := retarget(req, c.activeHost()); err != nil {
+			return nil, err
+		}
+		body, err = c.sendAuthenticated(req, attempt)
+	}
+
+	// A delete that had to be retried may have been applied by an earlier
+	// attempt whose response was lost, so the object already being gone
+	// means the delete succeeded.
+	if (attempt > 0 || tried > 1) && req.Method == http.MethodDelete && IsNotFound(err) {
+		return nil, nil
 	}
 
-	return nil
+	return body, err
 }
 
-func (c *Client) doRequest(req *http.Request) ([]byte, error) {
-	// Pre-flight check to ensure that login cookies are present.
-	if err := c.ensureAuth(req); err != nil {
+// sendAuthenticated sends a single attempt of a request with the client's
+// credentials. If the controller rejects them, for example because the
+// session expired part way through, the credentials are renewed and the
+// request is replayed once.
+func (c *Client) sendAuthenticated(req *http.Request, attempt int) ([]byte, error) {
+	ctx := req.Context()
+
+	// Pre-flight check to ensure that the request carries credentials.
+	generation, err := c.credentials.Authenticate(ctx, c, req)
+	if err != nil {
 		return nil, err
 	}
 
-	if req.Method == http.MethodPost {
-		req.Header.Add("Content-Type", "application/json")
+	body, err := c.send(req, attempt)
+	if !isSessionExpired(err) {
+		return body, err
+	}
+
+	tflog.SubsystemDebug(ctx, logSubsystem, "Bowtie API rejected credentials, refreshing them", map[string]interface{}{
+		"method": req.Method,
+		"path":   req.URL.Path,
+	})
+	refreshed, refreshErr := c.credentials.Refresh(ctx, c, req, generation)
+	if refreshErr != nil {
+		return nil, refreshErr
+	}
+	if !refreshed {
+		return body, err
+	}
+
+	if req, err = rewindRequest(req); err != nil {
+		return nil, err
+	}
+
+	if _, err := c.credentials.Authenticate(ctx, c, req); err != nil {
+		return nil, err
+	}
+
+	return c.send(req, attempt)
+}
+
+// send performs a single attempt of a request and returns the response
+// body, or an APIError if the API responded with a non-2xx status.
+func (c *Client) send(req *http.Request, attempt int) ([]byte, error) {
+	release, err := c.acquire(req.Context())
+	if err != nil {
+		return nil, err
 	}
+	defer release()
+
+	ctx, cancel := c.attemptContext(req.Context())
+	defer cancel()
+	req = req.WithContext(ctx)
+	traceRequestBody(ctx, req)
 
+	start := time.Now()
 	res, err := c.HTTPClient.Do(req)
 	if err != nil {
+		logRequest(ctx, req, nil, attempt, start, err)
 		return nil, err
 	}
+	defer res.Body.Close()
 
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
+		logRequest(ctx, req, nil, attempt, start, err)
 		return nil, err
 	}
 
+	logRequest(ctx, req, res, attempt, start, nil)
+	traceResponseBody(ctx, req, res, body)
+	c.throttle(ctx, res)
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return nil, newAPIError(req, res, body)
+	}
+
 	return body, nil
 }
 
+type requestTimeoutKey struct{}
+
+// ContextWithRequestTimeout lets each attempt of the requests made with
+// ctx take up to timeout when that is longer than the client's request
+// timeout. It is meant for requests the API is known to be slow to
+// answer, such as block list upserts, which fetch the upstream list
+// before responding.
+func ContextWithRequestTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, requestTimeoutKey{}, timeout)
+}
+
+// attemptContext bounds a single attempt of a request by the request
+// timeout, or the longer timeout ctx allows for it. If ctx carries an
+// earlier deadline, such as a resource's operation timeout running out,
+// that deadline applies instead, so one hanging attempt can't use up the
+// time left for retries and failover.
+func (c *Client) attemptContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	timeout := c.requestTimeout
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+
+	if longer, ok := ctx.Value(requestTimeoutKey{}).(time.Duration); ok && longer > timeout {
+		timeout = longer
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
+
 func (c *Client) getHostURL(path string) string {
 	if !strings.HasPrefix(path, "/") {
 		return ""
 	}
-	return fmt.Sprintf("%s%s%s", c.hostURL, apiVersionPrefix, path)
+	return fmt.Sprintf("%s%s%s", c.activeHost(), apiVersionPrefix, path)
 }
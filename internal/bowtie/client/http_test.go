@@ -1,8 +1,13 @@
 package client
 
 import (
+	"context"
+	"errors"
 	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func TestClient_getHostURL(t *testing.T) {
@@ -86,7 +91,9 @@ func TestClient_getHostURL(t *testing.T) {
 			c := &Client{
 				HTTPClient: tt.fields.HTTPClient,
 				hostURL:    tt.fields.hostURL,
-				auth:       tt.fields.auth,
+				credentials: &PasswordCredentials{
+					AuthPayload: tt.fields.auth,
+				},
 			}
 			if got := c.getHostURL(tt.args.path); got != tt.want {
 				t.Errorf("Client.getHostURL() = %v, want %v", got, tt.want)
@@ -94,3 +101,239 @@ func TestClient_getHostURL(t *testing.T) {
 		})
 	}
 }
+
+// newTestClient returns a client pointed at a test server which accepts
+// any login and hands out a session cookie before delegating to handler.
+func newTestClient(t *testing.T, handler http.HandlerFunc, opts ...Option) (*Client, *httptest.Server) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == apiVersionPrefix+"/user/login" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "valid", Path: "/"})
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(context.Background(), server.URL, "test@example.com", "passw0rd123", true, opts...)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	return c, server
+}
+
+func TestClient_doRequest(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		body       string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:   "ok",
+			status: http.StatusOK,
+			body:   `{"id":"1"}`,
+			want:   `{"id":"1"}`,
+		},
+		{
+			name:   "no content",
+			status: http.StatusNoContent,
+			want:   "",
+		},
+		{
+			name:       "not found",
+			status:     http.StatusNotFound,
+			body:       `{"message":"no such policy"}`,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "forbidden",
+			status:     http.StatusForbidden,
+			body:       "forbidden",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "server error",
+			status:     http.StatusInternalServerError,
+			body:       `{"error":"database unavailable"}`,
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			})
+
+			req, err := http.NewRequest(http.MethodGet, server.URL+apiVersionPrefix+"/policy", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := c.doRequest(req)
+			if tt.wantStatus == 0 {
+				if err != nil {
+					t.Fatalf("Client.doRequest() error = %v", err)
+				}
+				if string(got) != tt.want {
+					t.Errorf("Client.doRequest() = %v, want %v", string(got), tt.want)
+				}
+				return
+			}
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("Client.doRequest() error = %v, want *APIError", err)
+			}
+			if apiErr.StatusCode != tt.wantStatus {
+				t.Errorf("APIError.StatusCode = %v, want %v", apiErr.StatusCode, tt.wantStatus)
+			}
+			if apiErr.Method != http.MethodGet || apiErr.Path != apiVersionPrefix+"/policy" {
+				t.Errorf("APIError = %s %s, want GET %s/policy", apiErr.Method, apiErr.Path, apiVersionPrefix)
+			}
+		})
+	}
+}
+
+func TestClient_doRequest_cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	attempts := 0
+	c, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		cancel()
+		<-r.Context().Done()
+	}, WithRetryPolicy(testRetryPolicy()))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+apiVersionPrefix+"/policy", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.doRequest(req); !errors.Is(err, context.Canceled) {
+		t.Errorf("Client.doRequest() error = %v, want %v", err, context.Canceled)
+	}
+	if attempts != 1 {
+		t.Errorf("attempts = %d, want 1", attempts)
+	}
+}
+
+func TestClient_doRequest_timeout(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestTimeout time.Duration
+		longer         time.Duration
+		deadline       time.Duration
+		wantErr        error
+	}{
+		{
+			name:           "request timeout",
+			requestTimeout: 20 * time.Millisecond,
+			wantErr:        context.DeadlineExceeded,
+		},
+		{
+			name:           "request timeout within a longer operation deadline",
+			requestTimeout: 20 * time.Millisecond,
+			deadline:       time.Second,
+			wantErr:        context.DeadlineExceeded,
+		},
+		{
+			name:           "operation deadline shorter than the request timeout",
+			requestTimeout: time.Second,
+			deadline:       20 * time.Millisecond,
+			wantErr:        context.DeadlineExceeded,
+		},
+		{
+			name:           "longer request timeout for slow requests",
+			requestTimeout: 20 * time.Millisecond,
+			longer:         time.Second,
+			deadline:       2 * time.Second,
+		},
+		{
+			name:           "longer request timeout within a shorter operation deadline",
+			requestTimeout: 20 * time.Millisecond,
+			longer:         time.Second,
+			deadline:       50 * time.Millisecond,
+			wantErr:        context.DeadlineExceeded,
+		},
+		{
+			name:           "shorter request timeout for slow requests is ignored",
+			requestTimeout: 20 * time.Millisecond,
+			longer:         10 * time.Millisecond,
+			deadline:       time.Second,
+			wantErr:        context.DeadlineExceeded,
+		},
+		{
+			name:           "within both",
+			requestTimeout: time.Second,
+			deadline:       2 * time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				select {
+				case <-time.After(100 * time.Millisecond):
+					w.WriteHeader(http.StatusOK)
+				case <-r.Context().Done():
+				}
+			}, WithRequestTimeout(tt.requestTimeout), WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+
+			ctx := context.Background()
+			if tt.longer > 0 {
+				ctx = ContextWithRequestTimeout(ctx, tt.longer)
+			}
+			if tt.deadline > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.deadline)
+				defer cancel()
+			}
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+apiVersionPrefix+"/policy", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = c.doRequest(req)
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("Client.doRequest() error = %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("Client.doRequest() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// A hanging attempt is cut short by the request timeout even when the
+// operation has a longer deadline, leaving time to retry.
+func TestClient_doRequest_timeoutRetried(t *testing.T) {
+	var attempts int32
+	c, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			<-r.Context().Done()
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}, WithRequestTimeout(20*time.Millisecond), WithRetryPolicy(testRetryPolicy()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+apiVersionPrefix+"/policy", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.doRequest(req); err != nil {
+		t.Errorf("Client.doRequest() error = %v", err)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Errorf("attempts = %d, want 2", got)
+	}
+}
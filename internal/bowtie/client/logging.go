@@ -0,0 +1,149 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// logSubsystem is the tflog subsystem the client logs to. Request bodies
+// are only logged at trace level, which can be enabled for the client
+// alone with TF_LOG_PROVIDER_BOWTIE_API=trace.
+const logSubsystem = "api"
+
+const redacted = "***"
+
+// sensitiveKeys lists the JSON keys and HTTP headers, lower cased, whose
+// values are never written to the logs.
+var sensitiveKeys = map[string]bool{
+	"password":      true,
+	"token":         true,
+	"api_token":     true,
+	"secret":        true,
+	"private_key":   true,
+	"cookie":        true,
+	"set-cookie":    true,
+	"authorization": true,
+}
+
+// logContext returns ctx with the client's log subsystem attached and
+// masking set up for the configured credentials.
+func (c *Client) logContext(ctx context.Context) context.Context {
+	ctx = tflog.NewSubsystem(ctx, logSubsystem)
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, logSubsystem, "password", "cookie", "authorization")
+	for _, secret := range c.credentials.Secrets() {
+		if secret == "" {
+			continue
+		}
+		ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, logSubsystem, secret)
+		ctx = tflog.SubsystemMaskMessageStrings(ctx, logSubsystem, secret)
+	}
+	return ctx
+}
+
+// logRequest logs the outcome of a single attempt of a request.
+func logRequest(ctx context.Context, req *http.Request, res *http.Response, attempt int, start time.Time, err error) {
+	fields := map[string]interface{}{
+		"method":      req.Method,
+		"path":        req.URL.Path,
+		"attempt":     attempt + 1,
+		"duration_ms": time.Since(start).Milliseconds(),
+	}
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, logSubsystem, "Bowtie API request failed", fields)
+		return
+	}
+
+	fields["status_code"] = res.StatusCode
+	tflog.SubsystemDebug(ctx, logSubsystem, "Bowtie API request completed", fields)
+}
+
+// traceRequestBody logs the redacted body of an outgoing request.
+func traceRequestBody(ctx context.Context, req *http.Request) {
+	fields := map[string]interface{}{
+		"method":  req.Method,
+		"path":    req.URL.Path,
+		"headers": redactHeaders(req.Header),
+	}
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err == nil {
+			payload, _ := io.ReadAll(body)
+			body.Close()
+			fields["body"] = redactBody(payload)
+		}
+	}
+
+	tflog.SubsystemTrace(ctx, logSubsystem, "Sending Bowtie API request", fields)
+}
+
+// traceResponseBody logs the redacted body of a response.
+func traceResponseBody(ctx context.Context, req *http.Request, res *http.Response, body []byte) {
+	tflog.SubsystemTrace(ctx, logSubsystem, "Received Bowtie API response", map[string]interface{}{
+		"method":      req.Method,
+		"path":        req.URL.Path,
+		"status_code": res.StatusCode,
+		"headers":     redactHeaders(res.Header),
+		"body":        redactBody(body),
+	})
+}
+
+// redactHeaders flattens headers for logging, hiding cookies and other
+// credentials.
+func redactHeaders(headers http.Header) map[string]string {
+	flat := map[string]string{}
+	for name, values := range headers {
+		if sensitiveKeys[strings.ToLower(name)] {
+			flat[name] = redacted
+			continue
+		}
+		flat[name] = strings.Join(values, ", ")
+	}
+	return flat
+}
+
+// redactBody returns a body for logging with the values of sensitive JSON
+// keys hidden. Bodies that aren't JSON are logged as is.
+func redactBody(body []byte) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return ""
+	}
+
+	var payload interface{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return string(body)
+	}
+
+	out, err := json.Marshal(redactValue(payload))
+	if err != nil {
+		return string(body)
+	}
+	return string(out)
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range v {
+			if sensitiveKeys[strings.ToLower(key)] {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactValue(nested)
+		}
+	case []interface{}:
+		for i, nested := range v {
+			v[i] = redactValue(nested)
+		}
+	}
+	return value
+}
@@ -0,0 +1,118 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "empty",
+			body: "",
+			want: "",
+		},
+		{
+			name: "login",
+			body: `{"email":"test@example.com","password":"passw0rd123"}`,
+			want: `{"email":"test@example.com","password":"***"}`,
+		},
+		{
+			name: "nested",
+			body: `{"credentials":[{"name":"ci","Token":"abc"}],"id":"1"}`,
+			want: `{"credentials":[{"Token":"***","name":"ci"}],"id":"1"}`,
+		},
+		{
+			name: "not json",
+			body: "<html>Bad Gateway</html>",
+			want: "<html>Bad Gateway</html>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := redactBody([]byte(tt.body)); got != tt.want {
+				t.Errorf("redactBody() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("Content-Type", "application/json")
+	headers.Set("Cookie", "session=abc")
+	headers.Add("Set-Cookie", "session=def")
+	headers.Set("Authorization", "Bearer abc")
+
+	got := redactHeaders(headers)
+	want := map[string]string{
+		"Content-Type":  "application/json",
+		"Cookie":        redacted,
+		"Set-Cookie":    redacted,
+		"Authorization": redacted,
+	}
+	for name, value := range want {
+		if got[name] != value {
+			t.Errorf("redactHeaders()[%s] = %v, want %v", name, got[name], value)
+		}
+	}
+}
+
+func TestClient_doRequest_logging(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER_BOWTIE_API", "TRACE")
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	c, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"1","password":"passw0rd123"}`))
+	})
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+apiVersionPrefix+"/user/upsert", strings.NewReader(`{"id":"1"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.doRequest(req); err != nil {
+		t.Fatalf("Client.doRequest() error = %v", err)
+	}
+
+	logs := output.String()
+	if strings.Contains(logs, "passw0rd123") {
+		t.Errorf("logs contain the password: %s", logs)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("MultilineJSONDecode() error = %v", err)
+	}
+
+	messages := map[string]map[string]interface{}{}
+	for _, entry := range entries {
+		messages[entry["@message"].(string)] = entry
+	}
+
+	completed, ok := messages["Bowtie API request completed"]
+	if !ok {
+		t.Fatalf("no completed request logged, got %v", entries)
+	}
+	if completed["method"] != http.MethodPost || completed["path"] != apiVersionPrefix+"/user/upsert" || completed["status_code"] != float64(http.StatusOK) {
+		t.Errorf("completed request = %v, want POST %s/user/upsert returning 200", completed, apiVersionPrefix)
+	}
+
+	if _, ok := messages["Logging in to Bowtie API"]; !ok {
+		t.Errorf("no login logged, got %v", entries)
+	}
+	if _, ok := messages["Received Bowtie API response"]; !ok {
+		t.Errorf("no response body traced, got %v", entries)
+	}
+}
@@ -0,0 +1,299 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// BowtiePolicyPredicate selects which users or devices a policy applies
+// to. Exactly one of the fields is expected to be set: either a single
+// selector (user, device, group membership or always) or one of the
+// and/or/nor/not combinators, which nest arbitrarily deep.
+//
+// On the wire a predicate is an object with a single key naming the
+// selector or combinator, for example:
+//
+//	{"and": [{"in_user_group": "<id>"}, {"not": {"device": "<id>"}}]}
+type BowtiePolicyPredicate struct {
+	User          string
+	Device        string
+	InUserGroup   string
+	InDeviceGroup string
+	Always        bool
+
+	And []BowtiePolicyPredicate
+	Or  []BowtiePolicyPredicate
+	Nor []BowtiePolicyPredicate
+	Not *BowtiePolicyPredicate
+}
+
+const (
+	predicateUser          = "user"
+	predicateDevice        = "device"
+	predicateInUserGroup   = "in_user_group"
+	predicateInDeviceGroup = "in_device_group"
+	predicateAlways        = "always"
+	predicateAnd           = "and"
+	predicateOr            = "or"
+	predicateNor           = "nor"
+	predicateNot           = "not"
+)
+
+// ParsePolicyPredicate decodes a JSON encoded predicate and validates it.
+func ParsePolicyPredicate(data string) (BowtiePolicyPredicate, error) {
+	var predicate BowtiePolicyPredicate
+	if err := json.Unmarshal([]byte(data), &predicate); err != nil {
+		return BowtiePolicyPredicate{}, err
+	}
+
+	if err := predicate.Validate(); err != nil {
+		return BowtiePolicyPredicate{}, err
+	}
+
+	return predicate, nil
+}
+
+// String returns the canonical JSON encoding of the predicate, or an
+// empty string if the predicate cannot be encoded.
+func (p BowtiePolicyPredicate) String() string {
+	body, err := json.Marshal(p)
+	if err != nil {
+		return ""
+	}
+	return string(body)
+}
+
+// IsSelector reports whether the predicate is a single selector rather
+// than a combination of other predicates.
+func (p BowtiePolicyPredicate) IsSelector() bool {
+	return p.And == nil && p.Or == nil && p.Nor == nil && p.Not == nil
+}
+
+// Equal reports whether two predicates are structurally identical.
+func (p BowtiePolicyPredicate) Equal(other BowtiePolicyPredicate) bool {
+	return reflect.DeepEqual(p, other)
+}
+
+// keys lists which selectors or combinators are set on this predicate.
+func (p BowtiePolicyPredicate) keys() []string {
+	keys := []string{}
+	if p.User != "" {
+		keys = append(keys, predicateUser)
+	}
+	if p.Device != "" {
+		keys = append(keys, predicateDevice)
+	}
+	if p.InUserGroup != "" {
+		keys = append(keys, predicateInUserGroup)
+	}
+	if p.InDeviceGroup != "" {
+		keys = append(keys, predicateInDeviceGroup)
+	}
+	if p.Always {
+		keys = append(keys, predicateAlways)
+	}
+	if p.And != nil {
+		keys = append(keys, predicateAnd)
+	}
+	if p.Or != nil {
+		keys = append(keys, predicateOr)
+	}
+	if p.Nor != nil {
+		keys = append(keys, predicateNor)
+	}
+	if p.Not != nil {
+		keys = append(keys, predicateNot)
+	}
+	return keys
+}
+
+func (p BowtiePolicyPredicate) MarshalJSON() ([]byte, error) {
+	keys := p.keys()
+	if len(keys) != 1 {
+		return nil, fmt.Errorf("policy predicate must set exactly one of %s, found %d", strings.Join(predicateKeys(), ", "), len(keys))
+	}
+
+	var value interface{}
+	switch keys[0] {
+	case predicateUser:
+		value = p.User
+	case predicateDevice:
+		value = p.Device
+	case predicateInUserGroup:
+		value = p.InUserGroup
+	case predicateInDeviceGroup:
+		value = p.InDeviceGroup
+	case predicateAlways:
+		value = p.Always
+	case predicateAnd:
+		value = p.And
+	case predicateOr:
+		value = p.Or
+	case predicateNor:
+		value = p.Nor
+	case predicateNot:
+		value = p.Not
+	}
+
+	return json.Marshal(map[string]interface{}{keys[0]: value})
+}
+
+func (p *BowtiePolicyPredicate) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("policy predicate must be an object: %w", err)
+	}
+
+	if len(raw) != 1 {
+		return fmt.Errorf("policy predicate must set exactly one of %s, found %d", strings.Join(predicateKeys(), ", "), len(raw))
+	}
+
+	*p = BowtiePolicyPredicate{}
+	for key, value := range raw {
+		var err error
+		switch key {
+		case predicateUser:
+			err = json.Unmarshal(value, &p.User)
+		case predicateDevice:
+			err = json.Unmarshal(value, &p.Device)
+		case predicateInUserGroup:
+			err = json.Unmarshal(value, &p.InUserGroup)
+		case predicateInDeviceGroup:
+			err = json.Unmarshal(value, &p.InDeviceGroup)
+		case predicateAlways:
+			err = json.Unmarshal(value, &p.Always)
+		case predicateAnd:
+			err = unmarshalOperands(value, &p.And)
+		case predicateOr:
+			err = unmarshalOperands(value, &p.Or)
+		case predicateNor:
+			err = unmarshalOperands(value, &p.Nor)
+		case predicateNot:
+			p.Not = &BowtiePolicyPredicate{}
+			err = json.Unmarshal(value, p.Not)
+		default:
+			return fmt.Errorf("unknown policy predicate %q, expected one of %s", key, strings.Join(predicateKeys(), ", "))
+		}
+
+		if err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+func unmarshalOperands(data []byte, operands *[]BowtiePolicyPredicate) error {
+	*operands = []BowtiePolicyPredicate{}
+	return json.Unmarshal(data, operands)
+}
+
+// Validate checks that the predicate is well formed and can match at
+// least some traffic. Empty predicates, empty combinators and predicates
+// that contradict themselves are rejected.
+func (p BowtiePolicyPredicate) Validate() error {
+	return p.validate("predicate")
+}
+
+func (p BowtiePolicyPredicate) validate(location string) error {
+	keys := p.keys()
+	if len(keys) == 0 {
+		return fmt.Errorf("%s: predicate is empty, set one of %s", location, strings.Join(predicateKeys(), ", "))
+	}
+	if len(keys) > 1 {
+		return fmt.Errorf("%s: predicate sets more than one of %s, combine them with %q instead", location, strings.Join(keys, ", "), predicateAnd)
+	}
+
+	switch keys[0] {
+	case predicateAnd:
+		if err := validateOperands(location+"."+predicateAnd, p.And); err != nil {
+			return err
+		}
+		return validateConjunction(location+"."+predicateAnd, p.And)
+	case predicateOr:
+		return validateOperands(location+"."+predicateOr, p.Or)
+	case predicateNor:
+		if err := validateOperands(location+"."+predicateNor, p.Nor); err != nil {
+			return err
+		}
+		for i, operand := range p.Nor {
+			if operand.Always {
+				return fmt.Errorf("%s.%s[%d]: %q can never match when it excludes %q", location, predicateNor, i, predicateNor, predicateAlways)
+			}
+		}
+	case predicateNot:
+		if err := p.Not.validate(location + "." + predicateNot); err != nil {
+			return err
+		}
+		if p.Not.Always {
+			return fmt.Errorf("%s.%s: negating %q can never match", location, predicateNot, predicateAlways)
+		}
+	}
+
+	return nil
+}
+
+func validateOperands(location string, operands []BowtiePolicyPredicate) error {
+	if len(operands) == 0 {
+		return fmt.Errorf("%s: combinator must have at least one operand", location)
+	}
+
+	for i, operand := range operands {
+		if err := operand.validate(fmt.Sprintf("%s[%d]", location, i)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateConjunction rejects "and" predicates that can never be
+// satisfied: a predicate alongside its own negation, or more than one
+// distinct user or device since traffic only ever originates from one
+// of each.
+func validateConjunction(location string, operands []BowtiePolicyPredicate) error {
+	var user, device string
+	for i, operand := range operands {
+		if operand.User != "" {
+			if user != "" && user != operand.User {
+				return fmt.Errorf("%s[%d]: traffic cannot come from both user %q and user %q", location, i, user, operand.User)
+			}
+			user = operand.User
+		}
+
+		if operand.Device != "" {
+			if device != "" && device != operand.Device {
+				return fmt.Errorf("%s[%d]: traffic cannot come from both device %q and device %q", location, i, device, operand.Device)
+			}
+			device = operand.Device
+		}
+
+		if operand.Not == nil {
+			continue
+		}
+
+		for j, other := range operands {
+			if i != j && operand.Not.Equal(other) {
+				return fmt.Errorf("%s[%d]: contradicts %s[%d], a predicate cannot be combined with its own negation", location, i, location, j)
+			}
+		}
+	}
+
+	return nil
+}
+
+func predicateKeys() []string {
+	return []string{
+		predicateUser,
+		predicateDevice,
+		predicateInUserGroup,
+		predicateInDeviceGroup,
+		predicateAlways,
+		predicateAnd,
+		predicateOr,
+		predicateNor,
+		predicateNot,
+	}
+}
@@ -0,0 +1,185 @@
+package client
+
+import (
+	"testing"
+)
+
+func TestParsePolicyPredicate(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    BowtiePolicyPredicate
+		wantErr bool
+	}{
+		{
+			name: "selector",
+			data: `{"in_user_group": "engineering"}`,
+			want: BowtiePolicyPredicate{InUserGroup: "engineering"},
+		},
+		{
+			name: "always",
+			data: `{"always": true}`,
+			want: BowtiePolicyPredicate{Always: true},
+		},
+		{
+			name: "group and not device",
+			data: `{"and": [{"in_user_group": "engineering"}, {"not": {"device": "laptop"}}]}`,
+			want: BowtiePolicyPredicate{
+				And: []BowtiePolicyPredicate{
+					{InUserGroup: "engineering"},
+					{Not: &BowtiePolicyPredicate{Device: "laptop"}},
+				},
+			},
+		},
+		{
+			name: "deeply nested",
+			data: `{"or": [{"nor": [{"user": "jane"}, {"and": [{"in_device_group": "ci"}, {"user": "john"}]}]}, {"in_user_group": "admins"}]}`,
+			want: BowtiePolicyPredicate{
+				Or: []BowtiePolicyPredicate{
+					{Nor: []BowtiePolicyPredicate{
+						{User: "jane"},
+						{And: []BowtiePolicyPredicate{
+							{InDeviceGroup: "ci"},
+							{User: "john"},
+						}},
+					}},
+					{InUserGroup: "admins"},
+				},
+			},
+		},
+		{
+			name:    "empty object",
+			data:    `{}`,
+			wantErr: true,
+		},
+		{
+			name:    "always false",
+			data:    `{"always": false}`,
+			wantErr: true,
+		},
+		{
+			name:    "multiple keys",
+			data:    `{"user": "jane", "device": "laptop"}`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown key",
+			data:    `{"xor": []}`,
+			wantErr: true,
+		},
+		{
+			name:    "not an object",
+			data:    `["user"]`,
+			wantErr: true,
+		},
+		{
+			name:    "empty combinator",
+			data:    `{"or": []}`,
+			wantErr: true,
+		},
+		{
+			name:    "empty nested predicate",
+			data:    `{"and": [{"user": "jane"}, {"not": {}}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "negated always",
+			data:    `{"not": {"always": true}}`,
+			wantErr: true,
+		},
+		{
+			name:    "nor always",
+			data:    `{"nor": [{"user": "jane"}, {"always": true}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "and with own negation",
+			data:    `{"and": [{"in_user_group": "engineering"}, {"not": {"in_user_group": "engineering"}}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "and with two users",
+			data:    `{"and": [{"user": "jane"}, {"user": "john"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "and with two devices",
+			data:    `{"and": [{"device": "laptop"}, {"device": "phone"}]}`,
+			wantErr: true,
+		},
+		{
+			name: "or with two users",
+			data: `{"or": [{"user": "jane"}, {"user": "john"}]}`,
+			want: BowtiePolicyPredicate{
+				Or: []BowtiePolicyPredicate{
+					{User: "jane"},
+					{User: "john"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePolicyPredicate(tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParsePolicyPredicate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("ParsePolicyPredicate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBowtiePolicyPredicate_String(t *testing.T) {
+	tests := []struct {
+		name      string
+		predicate BowtiePolicyPredicate
+		want      string
+	}{
+		{
+			name:      "selector",
+			predicate: BowtiePolicyPredicate{User: "jane"},
+			want:      `{"user":"jane"}`,
+		},
+		{
+			name: "nested",
+			predicate: BowtiePolicyPredicate{
+				And: []BowtiePolicyPredicate{
+					{InUserGroup: "engineering"},
+					{Not: &BowtiePolicyPredicate{Device: "laptop"}},
+				},
+			},
+			want: `{"and":[{"in_user_group":"engineering"},{"not":{"device":"laptop"}}]}`,
+		},
+		{
+			name:      "empty",
+			predicate: BowtiePolicyPredicate{},
+			want:      "",
+		},
+		{
+			name:      "ambiguous",
+			predicate: BowtiePolicyPredicate{User: "jane", Always: true},
+			want:      "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.predicate.String(); got != tt.want {
+				t.Errorf("BowtiePolicyPredicate.String() = %v, want %v", got, tt.want)
+			}
+
+			if tt.want == "" {
+				return
+			}
+
+			roundTrip, err := ParsePolicyPredicate(tt.want)
+			if err != nil {
+				t.Fatalf("ParsePolicyPredicate() error = %v", err)
+			}
+			if !roundTrip.Equal(tt.predicate) {
+				t.Errorf("round trip = %+v, want %+v", roundTrip, tt.predicate)
+			}
+		})
+	}
+}
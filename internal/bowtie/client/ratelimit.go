@@ -0,0 +1,166 @@
+package client
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// WithRateLimit limits the client to requestsPerSecond requests on
+// average, so that parallel resources don't overwhelm small controllers.
+// Up to burst requests may be sent at once after the client was idle.
+// Zero disables the limit.
+func WithRateLimit(requestsPerSecond float64, burst int) Option {
+	return func(c *Client) {
+		c.limiter.rate = requestsPerSecond
+		c.limiter.burst = float64(burst)
+		if c.limiter.burst < 1 {
+			c.limiter.burst = 1
+		}
+	}
+}
+
+// WithMaxConcurrentRequests limits how many requests the client has in
+// flight at once. Zero disables the limit.
+func WithMaxConcurrentRequests(limit int) Option {
+	return func(c *Client) {
+		c.inFlight = nil
+		if limit > 0 {
+			c.inFlight = make(chan struct{}, limit)
+		}
+	}
+}
+
+// rateLimiter is a token bucket shared by every request of a client. It
+// also holds back all requests while the API asked the client to slow
+// down with a Retry-After header.
+type rateLimiter struct {
+	mu sync.Mutex
+
+	// rate is the number of tokens added per second, zero means
+	// requests are not rate limited.
+	rate  float64
+	burst float64
+
+	tokens float64
+	last   time.Time
+
+	// notBefore is when the API accepts requests again after a 429.
+	notBefore time.Time
+}
+
+// reserve takes a token for a request about to be sent at now and returns
+// how long the request must wait before it is sent. Tokens go negative
+// while requests queue up, so each waits for its own turn.
+func (l *rateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var delay time.Duration
+	if l.notBefore.After(now) {
+		delay = l.notBefore.Sub(now)
+	}
+
+	if l.rate <= 0 {
+		return delay
+	}
+
+	if l.last.IsZero() {
+		l.tokens = l.burst
+	} else {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens < 0 {
+		wait := time.Duration(-l.tokens / l.rate * float64(time.Second))
+		if wait > delay {
+			delay = wait
+		}
+	}
+
+	return delay
+}
+
+// pause holds back requests until the given time.
+func (l *rateLimiter) pause(until time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if until.After(l.notBefore) {
+		l.notBefore = until
+	}
+}
+
+// acquire blocks until the rate limit and the concurrency limit allow
+// another request to be sent. The returned func must be called once the
+// response has been read to free the request's slot.
+func (c *Client) acquire(ctx context.Context) (func(), error) {
+	if delay := c.limiter.reserve(time.Now()); delay > 0 {
+		tflog.SubsystemTrace(ctx, logSubsystem, "Waiting for Bowtie API rate limit", map[string]interface{}{
+			"delay": delay.String(),
+		})
+
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	if c.inFlight == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case c.inFlight <- struct{}{}:
+		return func() { <-c.inFlight }, nil
+	}
+}
+
+// throttle holds back every request of the client for as long as a 429
+// response asks to with its Retry-After header.
+func (c *Client) throttle(ctx context.Context, res *http.Response) {
+	if res.StatusCode != http.StatusTooManyRequests {
+		return
+	}
+
+	delay := retryAfter(res.Header.Get("Retry-After"), time.Now())
+	if delay <= 0 {
+		return
+	}
+
+	tflog.SubsystemWarn(ctx, logSubsystem, "Bowtie API is rate limiting requests, holding back requests", map[string]interface{}{
+		"retry_after": delay.String(),
+	})
+	c.limiter.pause(time.Now().Add(delay))
+}
+
+// retryAfter parses a Retry-After header, given either in seconds or as
+// an HTTP date, into the delay it asks for.
+func retryAfter(header string, now time.Time) time.Duration {
+	if header == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(header); err == nil {
+		return date.Sub(now)
+	}
+
+	return 0
+}
@@ -0,0 +1,166 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2023, time.June, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		header string
+		want   time.Duration
+	}{
+		{
+			name: "missing",
+			want: 0,
+		},
+		{
+			name:   "seconds",
+			header: "3",
+			want:   3 * time.Second,
+		},
+		{
+			name:   "http date",
+			header: now.Add(time.Minute).Format(http.TimeFormat),
+			want:   time.Minute,
+		},
+		{
+			name:   "invalid",
+			header: "soon",
+			want:   0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retryAfter(tt.header, now); got != tt.want {
+				t.Errorf("retryAfter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRateLimiter_reserve(t *testing.T) {
+	start := time.Date(2023, time.June, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		rate      float64
+		burst     float64
+		notBefore time.Time
+		requests  []time.Duration
+		want      []time.Duration
+	}{
+		{
+			name:     "unlimited",
+			requests: []time.Duration{0, 0, 0},
+			want:     []time.Duration{0, 0, 0},
+		},
+		{
+			name:     "queues requests beyond the burst",
+			rate:     2,
+			burst:    1,
+			requests: []time.Duration{0, 0, 0},
+			want:     []time.Duration{0, 500 * time.Millisecond, time.Second},
+		},
+		{
+			name:     "allows bursts after idling",
+			rate:     2,
+			burst:    2,
+			requests: []time.Duration{0, 0, 0, 5 * time.Second, 5 * time.Second},
+			want:     []time.Duration{0, 0, 500 * time.Millisecond, 0, 0},
+		},
+		{
+			name:      "holds back requests after a 429",
+			notBefore: start.Add(2 * time.Second),
+			requests:  []time.Duration{0, time.Second, 3 * time.Second},
+			want:      []time.Duration{2 * time.Second, time.Second, 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := &rateLimiter{rate: tt.rate, burst: tt.burst, notBefore: tt.notBefore}
+			for i, offset := range tt.requests {
+				if got := l.reserve(start.Add(offset)); got != tt.want[i] {
+					t.Errorf("request %d: rateLimiter.reserve() = %v, want %v", i, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestClient_doRequest_maxConcurrentRequests(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, peak := 0, 0
+
+	c, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > peak {
+			peak = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+	}, WithMaxConcurrentRequests(2))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			req, err := http.NewRequest(http.MethodGet, server.URL+apiVersionPrefix+"/policy", nil)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if _, err := c.doRequest(req); err != nil {
+				t.Errorf("Client.doRequest() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if peak > 2 {
+		t.Errorf("peak concurrent requests = %d, want at most 2", peak)
+	}
+}
+
+func TestClient_doRequest_tooManyRequests(t *testing.T) {
+	attempts := 0
+	c, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}, WithRetryPolicy(testRetryPolicy()))
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL+apiVersionPrefix+"/policy", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	if _, err := c.doRequest(req); err != nil {
+		t.Fatalf("Client.doRequest() error = %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("attempts = %d, want 2", attempts)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retried after %v, want at least the 1s asked for by Retry-After", elapsed)
+	}
+}
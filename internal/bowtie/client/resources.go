@@ -17,15 +17,28 @@ type PoliciesEndpointResponse struct {
 }
 
 type BowtiePolicy struct {
-	ID string `json:"id"`
-	// Source BowtiePolicySource `json:"source"`
-	// Dest   string             `json:"dest"`
-	// Action string             `json:"action"`
+	ID     string             `json:"id"`
+	Source BowtiePolicySource `json:"source"`
+	Dest   string             `json:"dest"`
+	Action string             `json:"action"`
 }
 
 type BowtiePolicySource struct {
-	ID        string `json:"id"`
-	Predicate string `json:"predicate"`
+	ID string `json:"id"`
+	// Predicate is kept in its wire form, so a policy whose predicate the
+	// provider can't interpret doesn't stop the rest of the policy
+	// document from being read. Use DecodePredicate to interpret it.
+	Predicate json.RawMessage `json:"predicate"`
+}
+
+// DecodePredicate decodes the predicate of the policy source.
+func (s BowtiePolicySource) DecodePredicate() (BowtiePolicyPredicate, error) {
+	var predicate BowtiePolicyPredicate
+	if err := json.Unmarshal(s.Predicate, &predicate); err != nil {
+		return BowtiePolicyPredicate{}, fmt.Errorf("policy source %s: %w", s.ID, err)
+	}
+
+	return predicate, nil
 }
 
 type BowtieResourceGroup struct {
@@ -94,7 +107,7 @@ func (c *Client) UpsertResource(ctx context.Context, id, name, protocol, ip, cid
 		return BowtieResource{}, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, c.getHostURL("/policy/upsert_resource"), bytes.NewBuffer(body))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.getHostURL("/policy/upsert_resource"), bytes.NewBuffer(body))
 	if err != nil {
 		return BowtieResource{}, err
 	}
@@ -113,13 +126,17 @@ func (c *Client) UpsertResource(ctx context.Context, id, name, protocol, ip, cid
 	return resource, nil
 }
 
-func (c *Client) GetPoliciesAndResources() (*PoliciesEndpointResponse, error) {
-	req, err := http.NewRequest(http.MethodGet, c.getHostURL("/policy"), nil)
-	if err != nil {
-		return nil, err
-	}
+// GetPoliciesAndResources returns every policy, resource and resource
+// group. Like the organization, the document is cached briefly.
+func (c *Client) GetPoliciesAndResources(ctx context.Context) (*PoliciesEndpointResponse, error) {
+	body, err := c.policies.get(ctx, func(ctx context.Context) ([]byte, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.getHostURL("/policy"), nil)
+		if err != nil {
+			return nil, err
+		}
 
-	body, err := c.doRequest(req)
+		return c.doRequest(req)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -129,24 +146,24 @@ func (c *Client) GetPoliciesAndResources() (*PoliciesEndpointResponse, error) {
 	return policy, err
 }
 
-func (c *Client) GetPolicy(id string) (BowtiePolicy, error) {
-	policyInfo, err := c.GetPoliciesAndResources()
+func (c *Client) GetPolicy(ctx context.Context, id string) (BowtiePolicy, error) {
+	policyInfo, err := c.GetPoliciesAndResources(ctx)
 	if err != nil {
 		return BowtiePolicy{}, err
 	}
 
 	policy, ok := policyInfo.Policies[id]
 	if !ok {
-		return BowtiePolicy{}, fmt.Errorf("policy not found")
+		return BowtiePolicy{}, fmt.Errorf("policy %s: %w", id, ErrNotFound)
 	}
 
 	return policy, nil
 }
 
-func (c *Client) GetResourceGroup(id string) (BowtieResourceGroup, error) {
-	rp, err := c.GetPoliciesAndResources()
+func (c *Client) GetResourceGroup(ctx context.Context, id string) (BowtieResourceGroup, error) {
+	rp, err := c.GetPoliciesAndResources(ctx)
 	if err != nil {
-		return BowtieResourceGroup{}, nil
+		return BowtieResourceGroup{}, err
 	}
 
 	for _, val := range rp.ResourceGroups {
@@ -155,11 +172,11 @@ func (c *Client) GetResourceGroup(id string) (BowtieResourceGroup, error) {
 		}
 	}
 
-	return BowtieResourceGroup{}, fmt.Errorf("resource_group not found")
+	return BowtieResourceGroup{}, fmt.Errorf("resource_group %s: %w", id, ErrNotFound)
 }
 
-func (c *Client) GetResource(id string) (BowtieResource, error) {
-	rp, err := c.GetPoliciesAndResources()
+func (c *Client) GetResource(ctx context.Context, id string) (BowtieResource, error) {
+	rp, err := c.GetPoliciesAndResources(ctx)
 	if err != nil {
 		return BowtieResource{}, err
 	}
@@ -169,11 +186,46 @@ func (c *Client) GetResource(id string) (BowtieResource, error) {
 			return val, nil
 		}
 	}
-	return BowtieResource{}, fmt.Errorf("expected resource not found")
+	return BowtieResource{}, fmt.Errorf("resource %s: %w", id, ErrNotFound)
+}
+
+func (c *Client) CreatePolicy(ctx context.Context, sourceID string, predicate BowtiePolicyPredicate, dest, action string) (string, error) {
+	id := uuid.NewString()
+	return id, c.UpsertPolicy(ctx, id, sourceID, predicate, dest, action)
+}
+
+func (c *Client) UpsertPolicy(ctx context.Context, id, sourceID string, predicate BowtiePolicyPredicate, dest, action string) error {
+	encodedPredicate, err := json.Marshal(predicate)
+	if err != nil {
+		return err
+	}
+
+	payload := BowtiePolicy{
+		ID: id,
+		Source: BowtiePolicySource{
+			ID:        sourceID,
+			Predicate: encodedPredicate,
+		},
+		Dest:   dest,
+		Action: action,
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.getHostURL("/policy/upsert_policy"), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
 }
 
-func (c *Client) DeletePolicy(id string) error {
-	req, err := http.NewRequest(http.MethodDelete, c.getHostURL(fmt.Sprintf("/policy/%s", id)), nil)
+func (c *Client) DeletePolicy(ctx context.Context, id string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.getHostURL(fmt.Sprintf("/policy/%s", id)), nil)
 	if err != nil {
 		return err
 	}
@@ -182,8 +234,8 @@ func (c *Client) DeletePolicy(id string) error {
 	return err
 }
 
-func (c *Client) DeleteResource(id string) error {
-	req, err := http.NewRequest(http.MethodDelete, c.getHostURL(fmt.Sprintf("/policy/resource/%s", id)), nil)
+func (c *Client) DeleteResource(ctx context.Context, id string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.getHostURL(fmt.Sprintf("/policy/resource/%s", id)), nil)
 	if err != nil {
 		return err
 	}
@@ -210,7 +262,7 @@ func (c *Client) UpsertResourceGroup(ctx context.Context, id, name string, resou
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, c.getHostURL("/policy/upsert_resource_group"), bytes.NewBuffer(body))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.getHostURL("/policy/upsert_resource_group"), bytes.NewBuffer(body))
 	if err != nil {
 		return err
 	}
@@ -219,8 +271,8 @@ func (c *Client) UpsertResourceGroup(ctx context.Context, id, name string, resou
 	return err
 }
 
-func (c *Client) DeleteResourceGroup(id string) error {
-	req, err := http.NewRequest(http.MethodDelete, c.getHostURL(fmt.Sprintf("/policy/resource_group/%s", id)), nil)
+func (c *Client) DeleteResourceGroup(ctx context.Context, id string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.getHostURL(fmt.Sprintf("/policy/resource_group/%s", id)), nil)
 	if err != nil {
 		return err
 	}
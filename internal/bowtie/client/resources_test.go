@@ -0,0 +1,144 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"reflect"
+	"testing"
+)
+
+const (
+	testPolicyID      = "e2a4c6b8-1d3f-4a5c-8e7b-9f0a1b2c3d4e"
+	testSourceID      = "4c6e8a0b-2d4f-4b6d-9f1a-3c5e7a9b1d2f"
+	testUserGroupID   = "5a2e9c41-8b7d-4f16-a3c0-9e1d2f4b6a58"
+	testDeviceID      = "9b1d3f5a-7c9e-4b1d-8f3a-5c7e9b1d3f5a"
+	testResourceGroup = "c3f1a9d2-6e4b-4c87-b0a5-1f2e3d4c5b6a"
+)
+
+func readTestdata(t *testing.T, name string) []byte {
+	t.Helper()
+
+	body, err := os.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatalf("reading testdata: %v", err)
+	}
+	return body
+}
+
+// assertJSONEqual compares two JSON documents, ignoring formatting and
+// key order.
+func assertJSONEqual(t *testing.T, got, want []byte) {
+	t.Helper()
+
+	var gotValue, wantValue interface{}
+	if err := json.Unmarshal(got, &gotValue); err != nil {
+		t.Fatalf("decoding %s: %v", got, err)
+	}
+	if err := json.Unmarshal(want, &wantValue); err != nil {
+		t.Fatalf("decoding %s: %v", want, err)
+	}
+	if !reflect.DeepEqual(gotValue, wantValue) {
+		t.Errorf("body = %s, want %s", got, want)
+	}
+}
+
+func TestClient_GetPolicy(t *testing.T) {
+	document := readTestdata(t, "policy.json")
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(document)
+	})
+
+	policy, err := c.GetPolicy(context.Background(), testPolicyID)
+	if err != nil {
+		t.Fatalf("Client.GetPolicy() error = %v", err)
+	}
+
+	if policy.ID != testPolicyID || policy.Source.ID != testSourceID || policy.Dest != testResourceGroup || policy.Action != "Reject" {
+		t.Errorf("Client.GetPolicy() = %+v", policy)
+	}
+
+	predicate, err := policy.Source.DecodePredicate()
+	if err != nil {
+		t.Fatalf("BowtiePolicySource.DecodePredicate() error = %v", err)
+	}
+	want := BowtiePolicyPredicate{
+		And: []BowtiePolicyPredicate{
+			{InUserGroup: testUserGroupID},
+			{Not: &BowtiePolicyPredicate{Device: testDeviceID}},
+		},
+	}
+	if !predicate.Equal(want) {
+		t.Errorf("BowtiePolicySource.DecodePredicate() = %v, want %v", predicate, want)
+	}
+}
+
+// Policies the provider can't interpret are left alone, so they must not
+// stop other policies, resources or resource groups from being read.
+func TestClient_GetPoliciesAndResources_undecodablePredicates(t *testing.T) {
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"policies": {
+				"good": {"id": "good", "source": {"id": "s1", "predicate": {"user": "jane"}}, "dest": "rg", "action": "Accept"},
+				"null": {"id": "null", "source": {"id": "s2", "predicate": null}, "dest": "rg", "action": "Accept"},
+				"multiple": {"id": "multiple", "source": {"id": "s3", "predicate": {"user": "jane", "device": "laptop"}}, "dest": "rg", "action": "Accept"},
+				"unknown": {"id": "unknown", "source": {"id": "s4", "predicate": {"xor": []}}, "dest": "rg", "action": "Accept"}
+			},
+			"resource_groups": {"rg": {"id": "rg", "name": "Internal", "inherited": [], "resources": ["wiki"]}},
+			"resources": {"wiki": {"id": "wiki", "name": "Wiki", "protocol": "https", "location": {"dns": "wiki.example.com"}, "ports": {"collection": {"ports": [443]}}}}
+		}`))
+	})
+	ctx := context.Background()
+
+	if _, err := c.GetResource(ctx, "wiki"); err != nil {
+		t.Errorf("Client.GetResource() error = %v", err)
+	}
+	if _, err := c.GetResourceGroup(ctx, "rg"); err != nil {
+		t.Errorf("Client.GetResourceGroup() error = %v", err)
+	}
+
+	policy, err := c.GetPolicy(ctx, "good")
+	if err != nil {
+		t.Fatalf("Client.GetPolicy() error = %v", err)
+	}
+	if _, err := policy.Source.DecodePredicate(); err != nil {
+		t.Errorf("BowtiePolicySource.DecodePredicate() error = %v", err)
+	}
+
+	for _, id := range []string{"null", "multiple", "unknown"} {
+		policy, err := c.GetPolicy(ctx, id)
+		if err != nil {
+			t.Fatalf("Client.GetPolicy(%q) error = %v", id, err)
+		}
+		if _, err := policy.Source.DecodePredicate(); err == nil {
+			t.Errorf("BowtiePolicySource.DecodePredicate() of %q succeeded, want error", id)
+		}
+	}
+}
+
+func TestClient_UpsertPolicy(t *testing.T) {
+	var body []byte
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != apiVersionPrefix+"/policy/upsert_policy" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	predicate := BowtiePolicyPredicate{
+		And: []BowtiePolicyPredicate{
+			{InUserGroup: testUserGroupID},
+			{Not: &BowtiePolicyPredicate{Device: testDeviceID}},
+		},
+	}
+	err := c.UpsertPolicy(context.Background(), testPolicyID, testSourceID, predicate, testResourceGroup, "Reject")
+	if err != nil {
+		t.Fatalf("Client.UpsertPolicy() error = %v", err)
+	}
+
+	assertJSONEqual(t, body, readTestdata(t, "upsert_policy.json"))
+}
@@ -0,0 +1,141 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy controls how the client retries requests that fail for
+// transient reasons, such as a controller restarting or a load balancer
+// briefly returning a 502 in the middle of a large apply.
+//
+// Every write the client performs is an upsert keyed by an ID chosen by
+// the client, so replaying a write is safe. Deletes are replayed as well,
+// but a delete that was retried and then reports the object as missing
+// is treated as successful since an earlier attempt must have removed it.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts made for a request,
+	// including the first. Values below 2 disable retries.
+	MaxAttempts int
+	// MinBackoff is the delay before the first retry. Each following
+	// retry doubles the delay up to MaxBackoff.
+	MinBackoff time.Duration
+	// MaxBackoff caps the delay between two attempts.
+	MaxBackoff time.Duration
+	// RetryableStatusCodes lists the HTTP status codes that are retried.
+	// Network errors such as refused or reset connections and timeouts
+	// are always retried.
+	RetryableStatusCodes []int
+}
+
+// DefaultRetryPolicy returns the retry policy used unless the client is
+// configured otherwise.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 4,
+		MinBackoff:  1 * time.Second,
+		MaxBackoff:  30 * time.Second,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// shouldRetry reports whether a request that failed with err on the given
+// zero-based attempt should be attempted again.
+func (p RetryPolicy) shouldRetry(ctx context.Context, attempt int, err error) bool {
+	if err == nil || attempt+1 >= p.MaxAttempts || ctx.Err() != nil {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		for _, code := range p.RetryableStatusCodes {
+			if apiErr.StatusCode == code {
+				return true
+			}
+		}
+		return false
+	}
+
+	return isTransientError(err)
+}
+
+// backoff returns how long to wait before the given one-based retry. The
+// delay grows exponentially and is jittered so that parallel resources
+// don't retry in lock step.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.MinBackoff
+	for i := 1; i < retry && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	if delay <= 0 {
+		return 0
+	}
+
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// wait blocks for the backoff of the given retry, returning early with
+// an error if the context is cancelled.
+func (p RetryPolicy) wait(ctx context.Context, retry int) error {
+	timer := time.NewTimer(p.backoff(retry))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func isTransientError(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// rewindRequest prepares a request to be sent again by resetting its body.
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	retry := req.Clone(req.Context())
+
+	// http.Client adds the cookie jar's cookies to the request it sends,
+	// drop them so the replay picks up a session renewed in the meantime.
+	retry.Header.Del("Cookie")
+
+	if req.Body == nil || req.GetBody == nil {
+		return retry, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	retry.Body = body
+
+	return retry, nil
+}
@@ -0,0 +1,156 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testRetryPolicy() RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.MinBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+	return policy
+}
+
+func TestClient_doRequest_retry(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		statuses     []int
+		wantAttempts int
+		wantErr      bool
+	}{
+		{
+			name:         "recovers from unavailable controller",
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK},
+			wantAttempts: 3,
+		},
+		{
+			name:         "gives up after max attempts",
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusGatewayTimeout, http.StatusGatewayTimeout, http.StatusGatewayTimeout, http.StatusGatewayTimeout, http.StatusOK},
+			wantAttempts: 4,
+			wantErr:      true,
+		},
+		{
+			name:         "does not retry client errors",
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusBadRequest, http.StatusOK},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "does not retry internal server errors",
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusInternalServerError, http.StatusOK},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "replays upserts",
+			method:       http.MethodPost,
+			statuses:     []int{http.StatusBadGateway, http.StatusOK},
+			wantAttempts: 2,
+		},
+		{
+			name:         "retried delete of missing object succeeds",
+			method:       http.MethodDelete,
+			statuses:     []int{http.StatusBadGateway, http.StatusNotFound},
+			wantAttempts: 2,
+		},
+		{
+			name:         "first delete of missing object fails",
+			method:       http.MethodDelete,
+			statuses:     []int{http.StatusNotFound},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			c, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPost {
+					body, _ := io.ReadAll(r.Body)
+					if string(body) != `{"id":"1"}` {
+						t.Errorf("attempt %d body = %q, want the original payload", attempts+1, string(body))
+					}
+				}
+
+				w.WriteHeader(tt.statuses[attempts])
+				attempts++
+			}, WithRetryPolicy(testRetryPolicy()))
+
+			var body io.Reader
+			if tt.method == http.MethodPost {
+				body = strings.NewReader(`{"id":"1"}`)
+			}
+			req, err := http.NewRequest(tt.method, server.URL+apiVersionPrefix+"/policy", body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = c.doRequest(req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", attempts, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestClient_doRequest_retryDisabled(t *testing.T) {
+	policy := testRetryPolicy()
+	policy.MaxAttempts = 1
+
+	attempts := 0
+	c, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}, WithRetryPolicy(policy))
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+apiVersionPrefix+"/policy", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.doRequest(req); !IsServerError(err) {
+		t.Errorf("Client.doRequest() error = %v, want a server error", err)
+	}
+	if attempts != 1 {
+		t.Errorf("attempts = %d, want 1", attempts)
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	policy := RetryPolicy{
+		MinBackoff: 100 * time.Millisecond,
+		MaxBackoff: time.Second,
+	}
+
+	tests := []struct {
+		retry int
+		max   time.Duration
+	}{
+		{retry: 1, max: 100 * time.Millisecond},
+		{retry: 2, max: 200 * time.Millisecond},
+		{retry: 3, max: 400 * time.Millisecond},
+		{retry: 4, max: 800 * time.Millisecond},
+		{retry: 5, max: time.Second},
+		{retry: 20, max: time.Second},
+	}
+	for _, tt := range tests {
+		for i := 0; i < 10; i++ {
+			got := policy.backoff(tt.retry)
+			if got < tt.max/2 || got > tt.max {
+				t.Errorf("RetryPolicy.backoff(%d) = %v, want between %v and %v", tt.retry, got, tt.max/2, tt.max)
+			}
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -9,8 +10,8 @@ import (
 	"github.com/google/uuid"
 )
 
-func (c *Client) ListSites() ([]Site, error) {
-	org, err := c.GetOrganization()
+func (c *Client) ListSites(ctx context.Context) ([]Site, error) {
+	org, err := c.GetOrganization(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -18,8 +19,8 @@ func (c *Client) ListSites() ([]Site, error) {
 	return org.Sites, nil
 }
 
-func (c *Client) GetSite(id string) (*Site, error) {
-	org, err := c.GetOrganization()
+func (c *Client) GetSite(ctx context.Context, id string) (*Site, error) {
+	org, err := c.GetOrganization(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -30,7 +31,18 @@ func (c *Client) GetSite(id string) (*Site, error) {
 		}
 	}
 
-	return nil, fmt.Errorf("site not found")
+	return nil, fmt.Errorf("site %s: %w", id, ErrNotFound)
+}
+
+func (c *Client) GetSiteByName(ctx context.Context, name string) (*Site, error) {
+	sites, err := c.ListSites(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return FindOne("site", name, sites, func(site Site) bool {
+		return site.Name == name
+	})
 }
 
 type SiteUpsertPayload struct {
@@ -38,9 +50,9 @@ type SiteUpsertPayload struct {
 	Name string `json:"name"`
 }
 
-func (c *Client) CreateSite(name string) (string, error) {
+func (c *Client) CreateSite(ctx context.Context, name string) (string, error) {
 	id := uuid.NewString()
-	err := c.UpsertSite(id, name)
+	err := c.UpsertSite(ctx, id, name)
 	if err != nil {
 		return "", err
 	}
@@ -48,7 +60,7 @@ func (c *Client) CreateSite(name string) (string, error) {
 	return id, nil
 }
 
-func (c *Client) UpsertSite(id, name string) error {
+func (c *Client) UpsertSite(ctx context.Context, id, name string) error {
 	payload := SiteUpsertPayload{
 		ID:   id,
 		Name: name,
@@ -59,7 +71,7 @@ func (c *Client) UpsertSite(id, name string) error {
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, c.getHostURL("/site"), strings.NewReader(string(requestPayload)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.getHostURL("/site"), strings.NewReader(string(requestPayload)))
 	if err != nil {
 		return err
 	}
@@ -84,8 +96,8 @@ type siteRangePayload struct {
 	Metric      int64  `json:"metric"`
 }
 
-func (c *Client) DeleteSite(siteID string) error {
-	req, err := http.NewRequest(http.MethodDelete, c.getHostURL(fmt.Sprintf("/site/%s", siteID)), nil)
+func (c *Client) DeleteSite(ctx context.Context, siteID string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.getHostURL(fmt.Sprintf("/site/%s", siteID)), nil)
 	if err != nil {
 		return err
 	}
@@ -94,13 +106,13 @@ func (c *Client) DeleteSite(siteID string) error {
 	return err
 }
 
-func (c *Client) CreateSiteRange(siteID, name, description, cidr string, isV4, isV6 bool, weight, metric int64) (string, error) {
+func (c *Client) CreateSiteRange(ctx context.Context, siteID, name, description, cidr string, isV4, isV6 bool, weight, metric int64) (string, error) {
 	id := uuid.NewString()
 
-	return id, c.UpsertSiteRange(siteID, id, name, description, cidr, isV4, isV6, weight, metric)
+	return id, c.UpsertSiteRange(ctx, siteID, id, name, description, cidr, isV4, isV6, weight, metric)
 }
 
-func (c *Client) UpsertSiteRange(siteID, id, name, description, cidr string, isV4, isV6 bool, weight, metric int64) error {
+func (c *Client) UpsertSiteRange(ctx context.Context, siteID, id, name, description, cidr string, isV4, isV6 bool, weight, metric int64) error {
 	payload := siteRangePayload{
 		ID:          id,
 		SiteID:      siteID,
@@ -117,7 +129,7 @@ func (c *Client) UpsertSiteRange(siteID, id, name, description, cidr string, isV
 	if err != nil {
 		return err
 	}
-	req, err := http.NewRequest(http.MethodPost, c.getHostURL(fmt.Sprintf("/site/%s/range", siteID)), strings.NewReader(string(requestBody)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.getHostURL(fmt.Sprintf("/site/%s/range", siteID)), strings.NewReader(string(requestBody)))
 	if err != nil {
 		return err
 	}
@@ -126,8 +138,8 @@ func (c *Client) UpsertSiteRange(siteID, id, name, description, cidr string, isV
 	return err
 }
 
-func (c *Client) DeleteSiteRange(siteID, id string) error {
-	req, err := http.NewRequest(http.MethodDelete, c.getHostURL(fmt.Sprintf("/site/%s/range/%s", siteID, id)), nil)
+func (c *Client) DeleteSiteRange(ctx context.Context, siteID, id string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.getHostURL(fmt.Sprintf("/site/%s/range/%s", siteID, id)), nil)
 	if err != nil {
 		return err
 	}
@@ -137,8 +149,8 @@ func (c *Client) DeleteSiteRange(siteID, id string) error {
 	return err
 }
 
-func (c *Client) GetSiteRange(siteID, id string) (*RoutableRange, error) {
-	org, err := c.GetOrganization()
+func (c *Client) GetSiteRange(ctx context.Context, siteID, id string) (*RoutableRange, error) {
+	org, err := c.GetOrganization(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -162,9 +174,9 @@ func (c *Client) GetSiteRange(siteID, id string) (*RoutableRange, error) {
 				}
 			}
 
-			return nil, fmt.Errorf("routable range not found in site: %s range: %s", siteID, id)
+			return nil, fmt.Errorf("routable range %s in site %s: %w", id, siteID, ErrNotFound)
 		}
 	}
 
-	return nil, fmt.Errorf("site not found: %s", siteID)
+	return nil, fmt.Errorf("site %s: %w", siteID, ErrNotFound)
 }
@@ -0,0 +1,89 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// TLSOptions describe how the client verifies the controller's
+// certificate and, for mutual TLS, which certificate it presents.
+type TLSOptions struct {
+	// CACertificatePEM holds PEM encoded certificates trusted in addition
+	// to the system roots, for controllers using a private CA or a
+	// self-signed certificate.
+	CACertificatePEM []byte
+	// ClientCertificatePEM and ClientKeyPEM hold the PEM encoded client
+	// certificate and private key presented to the controller.
+	ClientCertificatePEM []byte
+	ClientKeyPEM         []byte
+	// MinVersion is the minimum TLS version accepted, as one of the
+	// tls.Version constants. Zero keeps the Go default.
+	MinVersion uint16
+	// InsecureSkipVerify disables verification of the controller's
+	// certificate entirely.
+	InsecureSkipVerify bool
+}
+
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// TLSVersions lists the accepted values for ParseTLSVersion.
+func TLSVersions() []string {
+	return []string{"1.0", "1.1", "1.2", "1.3"}
+}
+
+// ParseTLSVersion converts a version such as "1.2" to its tls.Version
+// constant.
+func ParseTLSVersion(version string) (uint16, error) {
+	v, ok := tlsVersions[version]
+	if !ok {
+		return 0, fmt.Errorf("unsupported TLS version %q", version)
+	}
+	return v, nil
+}
+
+// Config builds the tls.Config described by the options.
+func (o TLSOptions) Config() (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion:         o.MinVersion,
+		InsecureSkipVerify: o.InsecureSkipVerify,
+	}
+
+	if len(o.CACertificatePEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(o.CACertificatePEM) {
+			return nil, errors.New("no certificates found in the CA certificate PEM")
+		}
+		config.RootCAs = pool
+	}
+
+	if len(o.ClientCertificatePEM) > 0 || len(o.ClientKeyPEM) > 0 {
+		cert, err := tls.X509KeyPair(o.ClientCertificatePEM, o.ClientKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
+
+// WithTLSConfig sets the TLS configuration used to connect to the
+// controller.
+func WithTLSConfig(config *tls.Config) Option {
+	return func(c *Client) {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = config
+		c.HTTPClient.Transport = transport
+	}
+}
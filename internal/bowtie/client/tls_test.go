@@ -0,0 +1,199 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// newClientCertificate returns a self-signed client certificate and key,
+// both PEM encoded.
+func newClientCertificate(t *testing.T) ([]byte, []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "terraform"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func newTLSTestServer(t *testing.T, config *tls.Config) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == apiVersionPrefix+"/user/login" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "valid", Path: "/"})
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	server.TLS = config
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestClient_TLS(t *testing.T) {
+	clientCert, clientKey := newClientCertificate(t)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AppendCertsFromPEM(clientCert)
+
+	tests := []struct {
+		name    string
+		server  *tls.Config
+		options func(server *httptest.Server) TLSOptions
+		wantErr bool
+	}{
+		{
+			name:   "untrusted certificate",
+			server: &tls.Config{},
+			options: func(server *httptest.Server) TLSOptions {
+				return TLSOptions{}
+			},
+			wantErr: true,
+		},
+		{
+			name:   "trusted CA",
+			server: &tls.Config{},
+			options: func(server *httptest.Server) TLSOptions {
+				return TLSOptions{
+					CACertificatePEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}),
+				}
+			},
+		},
+		{
+			name:   "insecure skip verify",
+			server: &tls.Config{},
+			options: func(server *httptest.Server) TLSOptions {
+				return TLSOptions{InsecureSkipVerify: true}
+			},
+		},
+		{
+			name:   "minimum version not offered by the controller",
+			server: &tls.Config{MaxVersion: tls.VersionTLS12},
+			options: func(server *httptest.Server) TLSOptions {
+				return TLSOptions{InsecureSkipVerify: true, MinVersion: tls.VersionTLS13}
+			},
+			wantErr: true,
+		},
+		{
+			name:   "client certificate",
+			server: &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs},
+			options: func(server *httptest.Server) TLSOptions {
+				return TLSOptions{
+					InsecureSkipVerify:   true,
+					ClientCertificatePEM: clientCert,
+					ClientKeyPEM:         clientKey,
+				}
+			},
+		},
+		{
+			name:   "missing client certificate",
+			server: &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs},
+			options: func(server *httptest.Server) TLSOptions {
+				return TLSOptions{InsecureSkipVerify: true}
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTLSTestServer(t, tt.server)
+
+			config, err := tt.options(server).Config()
+			if err != nil {
+				t.Fatalf("TLSOptions.Config() error = %v", err)
+			}
+
+			retry := testRetryPolicy()
+			retry.MaxAttempts = 1
+			_, err = NewClient(context.Background(), server.URL, "test@example.com", "passw0rd123", false, WithTLSConfig(config), WithRetryPolicy(retry))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewClient() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTLSOptions_Config(t *testing.T) {
+	clientCert, clientKey := newClientCertificate(t)
+
+	tests := []struct {
+		name    string
+		options TLSOptions
+		wantErr bool
+	}{
+		{
+			name:    "invalid CA",
+			options: TLSOptions{CACertificatePEM: []byte("not a certificate")},
+			wantErr: true,
+		},
+		{
+			name:    "client certificate without key",
+			options: TLSOptions{ClientCertificatePEM: clientCert},
+			wantErr: true,
+		},
+		{
+			name:    "mismatched client key",
+			options: TLSOptions{ClientCertificatePEM: clientCert, ClientKeyPEM: clientCert},
+			wantErr: true,
+		},
+		{
+			name:    "client certificate",
+			options: TLSOptions{ClientCertificatePEM: clientCert, ClientKeyPEM: clientKey},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.options.Config()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TLSOptions.Config() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseTLSVersion(t *testing.T) {
+	for _, version := range TLSVersions() {
+		if _, err := ParseTLSVersion(version); err != nil {
+			t.Errorf("ParseTLSVersion(%q) error = %v", version, err)
+		}
+	}
+
+	if _, err := ParseTLSVersion("1.4"); err == nil {
+		t.Error("ParseTLSVersion(\"1.4\") error = nil, want an error")
+	}
+}
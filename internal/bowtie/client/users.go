@@ -23,8 +23,8 @@ type BowtieUser struct {
 	Role              string `json:"role,omitempty"`
 }
 
-func (c *Client) GetUsers() (map[string]BowtieUser, error) {
-	req, err := http.NewRequest(http.MethodGet, c.getHostURL("/users"), nil)
+func (c *Client) GetUsers(ctx context.Context) (map[string]BowtieUser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.getHostURL("/users"), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -40,7 +40,7 @@ func (c *Client) GetUsers() (map[string]BowtieUser, error) {
 }
 
 func (c *Client) GetUserByEmail(ctx context.Context, email string) (BowtieUser, error) {
-	users, err := c.GetUsers()
+	users, err := c.GetUsers(ctx)
 	if err != nil {
 		return BowtieUser{}, err
 	}
@@ -51,13 +51,13 @@ func (c *Client) GetUserByEmail(ctx context.Context, email string) (BowtieUser,
 		}
 	}
 
-	return BowtieUser{}, fmt.Errorf("user not found")
+	return BowtieUser{}, fmt.Errorf("user %s: %w", email, ErrNotFound)
 }
 
 func (c *Client) GetUser(ctx context.Context, id string) (BowtieUser, error) {
-	req, err := http.NewRequest(http.MethodGet, c.getHostURL(fmt.Sprintf("/user/%s", id)), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.getHostURL(fmt.Sprintf("/user/%s", id)), nil)
 	if err != nil {
-		return BowtieUser{}, nil
+		return BowtieUser{}, err
 	}
 
 	body, err := c.doRequest(req)
@@ -71,7 +71,7 @@ func (c *Client) GetUser(ctx context.Context, id string) (BowtieUser, error) {
 }
 
 func (c *Client) DeleteUser(ctx context.Context, id string) error {
-	req, err := http.NewRequest(http.MethodDelete, c.getHostURL(fmt.Sprintf("/user/%s", id)), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.getHostURL(fmt.Sprintf("/user/%s", id)), nil)
 	if err != nil {
 		return err
 	}
@@ -91,7 +91,7 @@ func (c *Client) DisableUser(ctx context.Context, id string) error {
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, c.getHostURL("/user/upsert"), bytes.NewBuffer(body))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.getHostURL("/user/upsert"), bytes.NewBuffer(body))
 	if err != nil {
 		return err
 	}
@@ -127,7 +127,7 @@ func (c *Client) UpsertUser(ctx context.Context, id, name, email, role string, a
 		return "", err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, c.getHostURL("/user/upsert"), bytes.NewBuffer(body))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.getHostURL("/user/upsert"), bytes.NewBuffer(body))
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,209 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &controllersDataSource{}
+	_ datasource.DataSourceWithConfigure = &controllersDataSource{}
+)
+
+func NewControllersDataSource() datasource.DataSource {
+	return &controllersDataSource{}
+}
+
+type controllersDataSource struct {
+	client *client.Client
+}
+
+type controllersModel struct {
+	SiteID      types.String      `tfsdk:"site_id"`
+	Status      types.String      `tfsdk:"status"`
+	Controllers []controllerModel `tfsdk:"controllers"`
+}
+
+type controllerModel struct {
+	ID                  types.String `tfsdk:"id"`
+	SiteID              types.String `tfsdk:"site_id"`
+	PublicAddress       types.String `tfsdk:"public_address"`
+	SyncAddress         types.String `tfsdk:"sync_address"`
+	SyncState           types.String `tfsdk:"sync_state"`
+	Status              types.String `tfsdk:"status"`
+	Features            []string     `tfsdk:"features"`
+	WireguardPort       types.Int64  `tfsdk:"wireguard_port"`
+	PublicKey           types.String `tfsdk:"public_key"`
+	HTTPSEndpoint       types.String `tfsdk:"https_endpoint"`
+	PersistentKeepalive types.Int64  `tfsdk:"persistent_keepalive"`
+	DeviceID            types.String `tfsdk:"device_id"`
+	IPV6                types.String `tfsdk:"ipv6"`
+}
+
+func newControllerModel(controller client.Controller) controllerModel {
+	features := controller.Features
+	if features == nil {
+		features = []string{}
+	}
+
+	return controllerModel{
+		ID:                  types.StringValue(controller.ID),
+		SiteID:              types.StringValue(controller.SiteID),
+		PublicAddress:       types.StringValue(controller.PublicAddress),
+		SyncAddress:         types.StringValue(controller.SyncAddress),
+		SyncState:           types.StringValue(controller.SyncState),
+		Status:              types.StringValue(controller.Status),
+		Features:            features,
+		WireguardPort:       types.Int64Value(int64(controller.WireguardPort)),
+		PublicKey:           types.StringValue(controller.PublicKey),
+		HTTPSEndpoint:       types.StringValue(controller.HTTPSEndpoint),
+		PersistentKeepalive: types.Int64Value(int64(controller.PersistentKeepalive)),
+		DeviceID:            types.StringValue(controller.DeviceID),
+		IPV6:                types.StringValue(controller.IPV6),
+	}
+}
+
+// controllerAttributes describes a Controller, as listed by the
+// bowtie_controllers data source and in every site.
+func controllerAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Internal resource ID.",
+		},
+		"site_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The ID of the site the Controller belongs to.",
+		},
+		"public_address": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The public IP address or hostname devices use to reach the Controller.",
+		},
+		"sync_address": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The address other Controllers use to synchronize with the Controller.",
+		},
+		"sync_state": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The state of synchronization with the other Controllers of the organization.",
+		},
+		"status": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The status of the Controller.",
+		},
+		"features": schema.ListAttribute{
+			ElementType:         types.StringType,
+			Computed:            true,
+			MarkdownDescription: "The features enabled on the Controller.",
+		},
+		"wireguard_port": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The UDP port the Controller accepts WireGuard connections on.",
+		},
+		"public_key": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The WireGuard public key of the Controller.",
+		},
+		"https_endpoint": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The HTTPS endpoint serving the Controller's web interface and API.",
+		},
+		"persistent_keepalive": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The WireGuard persistent keepalive interval devices use with the Controller, in seconds.",
+		},
+		"device_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The ID of the device the Controller runs as.",
+		},
+		"ipv6": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The IPv6 address of the Controller within the organization.",
+		},
+	}
+}
+
+func (d *controllersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_controllers"
+}
+
+func (d *controllersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Reference the Controllers of the organization, for example to publish their endpoints in DNS or a load balancer.
+`,
+		Attributes: map[string]schema.Attribute{
+			"site_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return Controllers of the site with this ID.",
+			},
+			"status": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return Controllers with this status.",
+			},
+			"controllers": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Controllers matching the filters, ordered by ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: controllerAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *controllersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configuration Type",
+			fmt.Sprintf("Expected *client.Client, got: %T, please report this to the provider.", req.ProviderData),
+		)
+	}
+
+	d.client = client
+}
+
+func (d *controllersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state controllersModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	controllers, err := d.client.ListControllers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to retrieve controllers",
+			"Unexpected error retrieving controllers: "+err.Error(),
+		)
+		return
+	}
+
+	sort.Slice(controllers, func(i, j int) bool {
+		return controllers[i].ID < controllers[j].ID
+	})
+
+	state.Controllers = []controllerModel{}
+	for _, controller := range controllers {
+		if !state.SiteID.IsNull() && controller.SiteID != state.SiteID.ValueString() {
+			continue
+		}
+		if !state.Status.IsNull() && controller.Status != state.Status.ValueString() {
+			continue
+		}
+		state.Controllers = append(state.Controllers, newControllerModel(controller))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
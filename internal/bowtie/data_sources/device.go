@@ -0,0 +1,103 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ datasource.DataSource              = &deviceDataSource{}
+	_ datasource.DataSourceWithConfigure = &deviceDataSource{}
+)
+
+func NewDeviceDataSource() datasource.DataSource {
+	return &deviceDataSource{}
+}
+
+type deviceDataSource struct {
+	client *client.Client
+}
+
+func (d *deviceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device"
+}
+
+func (d *deviceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{}
+	for name, description := range deviceAttributes {
+		attributes[name] = schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: description,
+		}
+	}
+
+	attributes["serial"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The serial number of the device to look up. Exactly one of `serial` or `public_key` must be set.",
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("serial"), path.MatchRoot("public_key")),
+		},
+	}
+	attributes["public_key"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The WireGuard public key of the device to look up. Exactly one of `serial` or `public_key` must be set.",
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Reference a single device enrolled with the organization, looked up by its serial number or WireGuard public key.
+The lookup fails if more than one device matches, which can happen when hardware is re-enrolled under the same serial number.
+`,
+		Attributes: attributes,
+	}
+}
+
+func (d *deviceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configuration Type",
+			fmt.Sprintf("Expected *client.Client, got: %T, please report this to the provider.", req.ProviderData),
+		)
+	}
+
+	d.client = client
+}
+
+func (d *deviceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state deviceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var device *client.Device
+	var err error
+	if !state.Serial.IsNull() {
+		device, err = d.client.GetDeviceBySerial(ctx, state.Serial.ValueString())
+	} else {
+		device, err = d.client.GetDeviceByPublicKey(ctx, state.PublicKey.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to retrieve device",
+			"Unexpected error retrieving device: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newDeviceModel(*device))...)
+}
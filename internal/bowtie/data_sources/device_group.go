@@ -0,0 +1,128 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &deviceGroupDataSource{}
+	_ datasource.DataSourceWithConfigure = &deviceGroupDataSource{}
+)
+
+func NewDeviceGroupDataSource() datasource.DataSource {
+	return &deviceGroupDataSource{}
+}
+
+type deviceGroupDataSource struct {
+	client *client.Client
+}
+
+type deviceGroupModel struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Devices types.Set    `tfsdk:"devices"`
+}
+
+func (d *deviceGroupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_group"
+}
+
+func (d *deviceGroupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Reference a device group and its members, looked up by ID or name.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Internal resource ID. Exactly one of `id` or `name` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The human-readable name of the device group. Exactly one of `id` or `name` must be set. The lookup fails if more than one device group has this name.",
+			},
+			"devices": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "The IDs of the devices in the device group.",
+			},
+		},
+	}
+}
+
+func (d *deviceGroupDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configuration Type",
+			fmt.Sprintf("Expected *client.Client, got: %T, please report this to the provider.", req.ProviderData),
+		)
+	}
+
+	d.client = client
+}
+
+func (d *deviceGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state deviceGroupModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var group *client.DeviceGroup
+	var err error
+	if !state.ID.IsNull() {
+		group, err = d.client.GetDeviceGroup(ctx, state.ID.ValueString())
+	} else {
+		group, err = d.client.GetDeviceGroupByName(ctx, state.Name.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to retrieve device group",
+			"Unexpected error retrieving device group: "+err.Error(),
+		)
+		return
+	}
+
+	members, err := d.client.ListDevicesInDeviceGroup(ctx, group.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to list devices in device group",
+			"Unexpected error listing devices in device group: "+group.ID+" err: "+err.Error(),
+		)
+		return
+	}
+
+	if members.Devices == nil {
+		members.Devices = []string{}
+	}
+	devices, diags := types.SetValueFrom(ctx, types.StringType, members.Devices)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(group.ID)
+	state.Name = types.StringValue(group.Name)
+	state.Devices = devices
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,284 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &devicesDataSource{}
+	_ datasource.DataSourceWithConfigure = &devicesDataSource{}
+)
+
+func NewDevicesDataSource() datasource.DataSource {
+	return &devicesDataSource{}
+}
+
+type devicesDataSource struct {
+	client *client.Client
+}
+
+type devicesModel struct {
+	AssignedToUser types.String  `tfsdk:"assigned_to_user"`
+	DeviceType     types.String  `tfsdk:"device_type"`
+	DeviceOS       types.String  `tfsdk:"device_os"`
+	State          types.String  `tfsdk:"state"`
+	ControllerID   types.String  `tfsdk:"controller_id"`
+	LastSeenBefore types.String  `tfsdk:"last_seen_before"`
+	LastSeenAfter  types.String  `tfsdk:"last_seen_after"`
+	Devices        []deviceModel `tfsdk:"devices"`
+}
+
+type deviceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	IPV6            types.String `tfsdk:"ipv6"`
+	PublicKey       types.String `tfsdk:"public_key"`
+	Serial          types.String `tfsdk:"serial"`
+	State           types.String `tfsdk:"state"`
+	ControllerID    types.String `tfsdk:"controller_id"`
+	OwnedByOrg      types.String `tfsdk:"owned_by_org"`
+	AssignedToUser  types.String `tfsdk:"assigned_to_user"`
+	DeviceType      types.String `tfsdk:"device_type"`
+	DeviceOS        types.String `tfsdk:"device_os"`
+	LastSeen        types.String `tfsdk:"last_seen"`
+	LastSeenVersion types.String `tfsdk:"last_seen_version"`
+}
+
+func newDeviceModel(device client.Device) deviceModel {
+	return deviceModel{
+		ID:              types.StringValue(device.ID),
+		Name:            types.StringValue(device.Name),
+		IPV6:            types.StringValue(device.IPV6),
+		PublicKey:       types.StringValue(device.PublicKey),
+		Serial:          types.StringValue(device.Serial),
+		State:           types.StringValue(device.State),
+		ControllerID:    types.StringValue(device.ControllerID),
+		OwnedByOrg:      types.StringValue(device.OwnedByOrg),
+		AssignedToUser:  types.StringValue(device.AssignedToUser),
+		DeviceType:      types.StringValue(device.DeviceType),
+		DeviceOS:        types.StringValue(device.DeviceOS),
+		LastSeen:        types.StringValue(device.LastSeen),
+		LastSeenVersion: types.StringValue(device.LastSeenVersion),
+	}
+}
+
+// deviceAttributes describes every attribute of a device, as reported by
+// both the bowtie_device and bowtie_devices data sources.
+var deviceAttributes = map[string]string{
+	"id":                "Internal resource ID.",
+	"name":              "The human readable name of the device.",
+	"ipv6":              "The IPv6 address assigned to the device.",
+	"public_key":        "The WireGuard public key of the device.",
+	"serial":            "The serial number of the device.",
+	"state":             "Whether the device is approved to connect, one of `Pending`, `Accepted` or `Rejected`.",
+	"controller_id":     "The ID of the Controller the device last connected to.",
+	"owned_by_org":      "The ID of the organization the device belongs to.",
+	"assigned_to_user":  "The ID of the user that owns the device.",
+	"device_type":       "The type of the device, as reported by the device.",
+	"device_os":         "The operating system of the device, as reported by the device.",
+	"last_seen":         "The last time the device connected to a Controller, as an RFC 3339 timestamp.",
+	"last_seen_version": "The version of the Bowtie client the device last connected with.",
+}
+
+func (d *devicesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_devices"
+}
+
+func (d *devicesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	devices := map[string]schema.Attribute{}
+	for name, description := range deviceAttributes {
+		devices[name] = schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: description,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Reference the devices enrolled with the organization, for example to audit them or to use them in policies.
+All of the filters are optional, and a device must match every filter that is set to be returned.
+`,
+		Attributes: map[string]schema.Attribute{
+			"assigned_to_user": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return devices owned by the user with this ID.",
+			},
+			"device_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return devices of this type.",
+			},
+			"device_os": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return devices running this operating system.",
+			},
+			"state": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return devices in this state, one of `Pending`, `Accepted` or `Rejected`.",
+			},
+			"controller_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return devices that last connected to the Controller with this ID.",
+			},
+			"last_seen_before": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return devices last seen before this time, as an RFC 3339 timestamp such as `2023-06-01T00:00:00Z`. Devices that were never seen are returned too.",
+			},
+			"last_seen_after": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return devices last seen after this time, as an RFC 3339 timestamp such as `2023-06-01T00:00:00Z`.",
+			},
+			"devices": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The devices matching the filters, ordered by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: devices,
+				},
+			},
+		},
+	}
+}
+
+func (d *devicesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configuration Type",
+			fmt.Sprintf("Expected *client.Client, got: %T, please report this to the provider.", req.ProviderData),
+		)
+	}
+
+	d.client = client
+}
+
+func (d *devicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state devicesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := deviceFilter{
+		AssignedToUser: state.AssignedToUser.ValueString(),
+		DeviceType:     state.DeviceType.ValueString(),
+		DeviceOS:       state.DeviceOS.ValueString(),
+		State:          state.State.ValueString(),
+		ControllerID:   state.ControllerID.ValueString(),
+	}
+
+	var err error
+	if filter.LastSeenBefore, err = parseTimestamp(state.LastSeenBefore); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("last_seen_before"), "Invalid Timestamp", err.Error())
+	}
+	if filter.LastSeenAfter, err = parseTimestamp(state.LastSeenAfter); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("last_seen_after"), "Invalid Timestamp", err.Error())
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	devices, err := d.client.ListDevices(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to retrieve devices",
+			"Unexpected error retrieving devices: "+err.Error(),
+		)
+		return
+	}
+
+	state.Devices = []deviceModel{}
+	for _, device := range filter.apply(devices) {
+		state.Devices = append(state.Devices, newDeviceModel(device))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// deviceFilter selects devices by their attributes. Empty fields match
+// any device.
+type deviceFilter struct {
+	AssignedToUser string
+	DeviceType     string
+	DeviceOS       string
+	State          string
+	ControllerID   string
+	LastSeenBefore time.Time
+	LastSeenAfter  time.Time
+}
+
+// apply returns the devices matching the filter, ordered by name and ID.
+func (f deviceFilter) apply(devices map[string]client.Device) []client.Device {
+	matches := []client.Device{}
+	for _, device := range devices {
+		if f.matches(device) {
+			matches = append(matches, device)
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Name != matches[j].Name {
+			return matches[i].Name < matches[j].Name
+		}
+		return matches[i].ID < matches[j].ID
+	})
+
+	return matches
+}
+
+func (f deviceFilter) matches(device client.Device) bool {
+	for _, field := range []struct{ want, got string }{
+		{f.AssignedToUser, device.AssignedToUser},
+		{f.DeviceType, device.DeviceType},
+		{f.DeviceOS, device.DeviceOS},
+		{f.State, device.State},
+		{f.ControllerID, device.ControllerID},
+	} {
+		if field.want != "" && field.want != field.got {
+			return false
+		}
+	}
+
+	if f.LastSeenBefore.IsZero() && f.LastSeenAfter.IsZero() {
+		return true
+	}
+
+	// A device that was never seen is as stale as it gets.
+	lastSeen, err := time.Parse(time.RFC3339, device.LastSeen)
+	if err != nil {
+		return f.LastSeenAfter.IsZero()
+	}
+
+	if !f.LastSeenBefore.IsZero() && !lastSeen.Before(f.LastSeenBefore) {
+		return false
+	}
+	if !f.LastSeenAfter.IsZero() && !lastSeen.After(f.LastSeenAfter) {
+		return false
+	}
+
+	return true
+}
+
+func parseTimestamp(value types.String) (time.Time, error) {
+	if value.IsNull() || value.IsUnknown() {
+		return time.Time{}, nil
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is not an RFC 3339 timestamp such as \"2023-06-01T00:00:00Z\": %w", value.ValueString(), err)
+	}
+
+	return timestamp, nil
+}
@@ -0,0 +1,99 @@
+package data_sources
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+)
+
+func Test_deviceFilter_apply(t *testing.T) {
+	devices := map[string]client.Device{
+		"laptop": {
+			ID:             "laptop",
+			Name:           "Laptop",
+			State:          client.DeviceStateAccepted,
+			AssignedToUser: "alex",
+			DeviceOS:       "macOS",
+			ControllerID:   "east",
+			LastSeen:       "2023-06-01T12:00:00Z",
+		},
+		"phone": {
+			ID:             "phone",
+			Name:           "Phone",
+			State:          client.DeviceStatePending,
+			AssignedToUser: "alex",
+			DeviceOS:       "iOS",
+			ControllerID:   "west",
+			LastSeen:       "2023-01-01T12:00:00Z",
+		},
+		"kiosk": {
+			ID:       "kiosk",
+			Name:     "Kiosk",
+			State:    client.DeviceStatePending,
+			DeviceOS: "Linux",
+		},
+	}
+
+	tests := []struct {
+		name   string
+		filter deviceFilter
+		want   []string
+	}{
+		{
+			name:   "no filters",
+			filter: deviceFilter{},
+			want:   []string{"kiosk", "laptop", "phone"},
+		},
+		{
+			name:   "owner",
+			filter: deviceFilter{AssignedToUser: "alex"},
+			want:   []string{"laptop", "phone"},
+		},
+		{
+			name:   "every filter must match",
+			filter: deviceFilter{AssignedToUser: "alex", State: client.DeviceStatePending},
+			want:   []string{"phone"},
+		},
+		{
+			name:   "controller",
+			filter: deviceFilter{ControllerID: "east"},
+			want:   []string{"laptop"},
+		},
+		{
+			name:   "no matches",
+			filter: deviceFilter{DeviceOS: "Windows"},
+			want:   []string{},
+		},
+		{
+			name:   "last seen before includes never seen devices",
+			filter: deviceFilter{LastSeenBefore: time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)},
+			want:   []string{"kiosk", "phone"},
+		},
+		{
+			name:   "last seen after",
+			filter: deviceFilter{LastSeenAfter: time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)},
+			want:   []string{"laptop"},
+		},
+		{
+			name: "last seen between",
+			filter: deviceFilter{
+				LastSeenAfter:  time.Date(2022, time.December, 1, 0, 0, 0, 0, time.UTC),
+				LastSeenBefore: time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC),
+			},
+			want: []string{"phone"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			for _, device := range tt.filter.apply(devices) {
+				got = append(got, device.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("deviceFilter.apply() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ datasource.DataSource              = &dnsDataSource{}
+	_ datasource.DataSourceWithConfigure = &dnsDataSource{}
+)
+
+func NewDNSDataSource() datasource.DataSource {
+	return &dnsDataSource{}
+}
+
+type dnsDataSource struct {
+	client *client.Client
+}
+
+func (d *dnsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns"
+}
+
+func (d *dnsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := dnsZoneAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Internal resource ID. Exactly one of `id` or `name` must be set.",
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The DNS zone name, such as `example.com`. Exactly one of `id` or `name` must be set. The lookup fails if more than one DNS zone has this name.",
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Reference a DNS zone managed elsewhere, looked up by ID or name.
+`,
+		Attributes: attributes,
+	}
+}
+
+func (d *dnsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configuration Type",
+			fmt.Sprintf("Expected *client.Client, got: %T, please report this to the provider.", req.ProviderData),
+		)
+	}
+
+	d.client = client
+}
+
+func (d *dnsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state dnsZoneModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var dns *client.DNS
+	var err error
+	if !state.ID.IsNull() {
+		dns, err = d.client.GetDNS(ctx, state.ID.ValueString())
+	} else {
+		dns, err = d.client.GetDNSByName(ctx, state.Name.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to retrieve DNS zone",
+			"Unexpected error retrieving DNS zone: "+err.Error(),
+		)
+		return
+	}
+
+	state = newDNSZoneModel(*dns)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,97 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ datasource.DataSource              = &dnsBlockListDataSource{}
+	_ datasource.DataSourceWithConfigure = &dnsBlockListDataSource{}
+)
+
+func NewDNSBlockListDataSource() datasource.DataSource {
+	return &dnsBlockListDataSource{}
+}
+
+type dnsBlockListDataSource struct {
+	client *client.Client
+}
+
+func (d *dnsBlockListDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_block_list"
+}
+
+func (d *dnsBlockListDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := dnsBlockListAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Internal resource ID. Exactly one of `id` or `name` must be set.",
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The human readable name of the block list. Exactly one of `id` or `name` must be set. The lookup fails if more than one block list has this name.",
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Reference a DNS block list managed elsewhere, looked up by ID or name.
+`,
+		Attributes: attributes,
+	}
+}
+
+func (d *dnsBlockListDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configuration Type",
+			fmt.Sprintf("Expected *client.Client, got: %T, please report this to the provider.", req.ProviderData),
+		)
+	}
+
+	d.client = client
+}
+
+func (d *dnsBlockListDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state dnsBlockListModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var blocklist *client.DNSBlockList
+	var err error
+	if !state.ID.IsNull() {
+		blocklist, err = d.client.GetDNSBlockList(ctx, state.ID.ValueString())
+	} else {
+		blocklist, err = d.client.GetDNSBlockListByName(ctx, state.Name.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to retrieve DNS block list",
+			"Unexpected error retrieving DNS block list: "+err.Error(),
+		)
+		return
+	}
+
+	state = newDNSBlockListModel(*blocklist)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,175 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &dnsBlockListsDataSource{}
+	_ datasource.DataSourceWithConfigure = &dnsBlockListsDataSource{}
+)
+
+func NewDNSBlockListsDataSource() datasource.DataSource {
+	return &dnsBlockListsDataSource{}
+}
+
+type dnsBlockListsDataSource struct {
+	client *client.Client
+}
+
+type dnsBlockListsModel struct {
+	NameRegex     types.String        `tfsdk:"name_regex"`
+	DNSBlockLists []dnsBlockListModel `tfsdk:"dns_block_lists"`
+}
+
+type dnsBlockListModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Upstream        types.String `tfsdk:"upstream"`
+	OverrideToAllow []string     `tfsdk:"override_to_allow"`
+}
+
+// newDNSBlockListModel converts a block list. The API keeps the names
+// to allow as a single newline-separated string.
+func newDNSBlockListModel(blocklist client.DNSBlockList) dnsBlockListModel {
+	model := dnsBlockListModel{
+		ID:              types.StringValue(blocklist.ID),
+		Name:            types.StringValue(blocklist.Name),
+		Upstream:        types.StringValue(blocklist.Upstream),
+		OverrideToAllow: []string{},
+	}
+
+	for _, name := range strings.Split(blocklist.OverrideToAllow, "\n") {
+		if name != "" {
+			model.OverrideToAllow = append(model.OverrideToAllow, name)
+		}
+	}
+
+	return model
+}
+
+// dnsBlockListAttributes describes a DNS block list, as reported by both
+// the bowtie_dns_block_list and bowtie_dns_block_lists data sources.
+func dnsBlockListAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Internal resource ID.",
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The human readable name of the block list.",
+		},
+		"upstream": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The upstream URL the DNS block list is retrieved from.",
+		},
+		"override_to_allow": schema.ListAttribute{
+			ElementType:         types.StringType,
+			Computed:            true,
+			MarkdownDescription: "The DNS names excluded from the retrieved DNS block list.",
+		},
+	}
+}
+
+func (d *dnsBlockListsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_block_lists"
+}
+
+func (d *dnsBlockListsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Reference the DNS block lists of the organization, optionally only those whose name matches a regular expression.
+`,
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return block lists whose name matches this [regular expression](https://github.com/google/re2/wiki/Syntax). The expression isn't anchored, so use `^` and `$` to match whole names.",
+			},
+			"dns_block_lists": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The block lists matching `name_regex`, ordered by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: dnsBlockListAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *dnsBlockListsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configuration Type",
+			fmt.Sprintf("Expected *client.Client, got: %T, please report this to the provider.", req.ProviderData),
+		)
+	}
+
+	d.client = client
+}
+
+func (d *dnsBlockListsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state dnsBlockListsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid regular expression",
+				"The name_regex could not be parsed: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	blocklists, err := d.client.GetDNSBlockLists(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to retrieve DNS block lists",
+			"Unexpected error retrieving DNS block lists: "+err.Error(),
+		)
+		return
+	}
+
+	matches := []client.DNSBlockList{}
+	for _, blocklist := range blocklists {
+		if nameRegex == nil || nameRegex.MatchString(blocklist.Name) {
+			matches = append(matches, blocklist)
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Name != matches[j].Name {
+			return matches[i].Name < matches[j].Name
+		}
+		return matches[i].ID < matches[j].ID
+	})
+
+	state.DNSBlockLists = []dnsBlockListModel{}
+	for _, blocklist := range matches {
+		state.DNSBlockLists = append(state.DNSBlockLists, newDNSBlockListModel(blocklist))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
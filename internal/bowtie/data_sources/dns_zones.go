@@ -0,0 +1,279 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &dnsZonesDataSource{}
+	_ datasource.DataSourceWithConfigure = &dnsZonesDataSource{}
+)
+
+func NewDNSZonesDataSource() datasource.DataSource {
+	return &dnsZonesDataSource{}
+}
+
+type dnsZonesDataSource struct {
+	client *client.Client
+}
+
+type dnsZonesModel struct {
+	NameRegex types.String   `tfsdk:"name_regex"`
+	DNSZones  []dnsZoneModel `tfsdk:"dns_zones"`
+}
+
+type dnsZoneModel struct {
+	ID               types.String      `tfsdk:"id"`
+	Name             types.String      `tfsdk:"name"`
+	Servers          []dnsServerModel  `tfsdk:"servers"`
+	IncludeOnlySites []string          `tfsdk:"include_only_sites"`
+	IsCounted        types.Bool        `tfsdk:"is_counted"`
+	IsDNS64          types.Bool        `tfsdk:"is_dns64"`
+	IsLog            types.Bool        `tfsdk:"is_log"`
+	IsDropA          types.Bool        `tfsdk:"is_drop_a"`
+	IsDropAll        types.Bool        `tfsdk:"is_drop_all"`
+	IsSearchDomain   types.Bool        `tfsdk:"is_search_domain"`
+	Excludes         []dnsExcludeModel `tfsdk:"excludes"`
+}
+
+type dnsServerModel struct {
+	ID    types.String `tfsdk:"id"`
+	Addr  types.String `tfsdk:"addr"`
+	Order types.Int64  `tfsdk:"order"`
+}
+
+type dnsExcludeModel struct {
+	ID    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Order types.Int64  `tfsdk:"order"`
+}
+
+// newDNSZoneModel converts a DNS zone, ordering its servers and excludes
+// by the order the controller consults them in.
+func newDNSZoneModel(dns client.DNS) dnsZoneModel {
+	model := dnsZoneModel{
+		ID:               types.StringValue(dns.ID),
+		Name:             types.StringValue(dns.Name),
+		Servers:          []dnsServerModel{},
+		IncludeOnlySites: dns.IncludeOnlySites,
+		IsCounted:        types.BoolValue(dns.IsCounted),
+		IsDNS64:          types.BoolValue(dns.IsDNS64),
+		IsLog:            types.BoolValue(dns.IsLog),
+		IsDropA:          types.BoolValue(dns.IsDropA),
+		IsDropAll:        types.BoolValue(dns.IsDropAll),
+		IsSearchDomain:   types.BoolValue(dns.IsSearchDomain),
+		Excludes:         []dnsExcludeModel{},
+	}
+	if model.IncludeOnlySites == nil {
+		model.IncludeOnlySites = []string{}
+	}
+
+	for _, server := range dns.Servers {
+		model.Servers = append(model.Servers, dnsServerModel{
+			ID:    types.StringValue(server.ID),
+			Addr:  types.StringValue(server.Addr),
+			Order: types.Int64Value(server.Order),
+		})
+	}
+	sort.Slice(model.Servers, func(i, j int) bool {
+		return model.Servers[i].Order.ValueInt64() < model.Servers[j].Order.ValueInt64()
+	})
+
+	for _, exclude := range dns.DNS64Exclude {
+		model.Excludes = append(model.Excludes, dnsExcludeModel{
+			ID:    types.StringValue(exclude.ID),
+			Name:  types.StringValue(exclude.Name),
+			Order: types.Int64Value(exclude.Order),
+		})
+	}
+	sort.Slice(model.Excludes, func(i, j int) bool {
+		return model.Excludes[i].Order.ValueInt64() < model.Excludes[j].Order.ValueInt64()
+	})
+
+	return model
+}
+
+// dnsZoneAttributes describes a DNS zone with the same attributes as the
+// bowtie_dns resource.
+func dnsZoneAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Internal resource ID.",
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The DNS zone name.",
+		},
+		"servers": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "The upstream DNS servers queried for the zone, in order.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Internal resource ID.",
+					},
+					"addr": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The address of the DNS server.",
+					},
+					"order": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "The position of the DNS server in the order servers are queried in.",
+					},
+				},
+			},
+		},
+		"include_only_sites": schema.ListAttribute{
+			ElementType:         types.StringType,
+			Computed:            true,
+			MarkdownDescription: "The IDs of the sites the zone is restricted to, or empty if it applies to every site.",
+		},
+		"is_counted": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether queries for the zone are counted.",
+		},
+		"is_dns64": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether DNS64 is enabled for the zone.",
+		},
+		"is_log": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether queries for the zone are logged.",
+		},
+		"is_drop_a": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether A records are dropped from responses for the zone.",
+		},
+		"is_drop_all": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether all queries for the zone are dropped.",
+		},
+		"is_search_domain": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether the zone is a search domain.",
+		},
+		"excludes": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "The names excluded from DNS64, in order.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Internal resource ID.",
+					},
+					"name": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The excluded name.",
+					},
+					"order": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "The position of the exclusion.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dnsZonesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zones"
+}
+
+func (d *dnsZonesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Reference the DNS zones of the organization, optionally only those whose name matches a regular expression.
+`,
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return DNS zones whose name matches this [regular expression](https://github.com/google/re2/wiki/Syntax). The expression isn't anchored, so use `^` and `$` to match whole names.",
+			},
+			"dns_zones": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The DNS zones matching `name_regex`, ordered by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: dnsZoneAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *dnsZonesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configuration Type",
+			fmt.Sprintf("Expected *client.Client, got: %T, please report this to the provider.", req.ProviderData),
+		)
+	}
+
+	d.client = client
+}
+
+func (d *dnsZonesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state dnsZonesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid regular expression",
+				"The name_regex could not be parsed: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	org, err := d.client.GetOrganization(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to retrieve DNS zones",
+			"Unexpected error retrieving DNS zones: "+err.Error(),
+		)
+		return
+	}
+
+	matches := []client.DNS{}
+	for _, dns := range org.DNS {
+		if nameRegex == nil || nameRegex.MatchString(dns.Name) {
+			matches = append(matches, dns)
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Name != matches[j].Name {
+			return matches[i].Name < matches[j].Name
+		}
+		return matches[i].ID < matches[j].ID
+	})
+
+	state.DNSZones = []dnsZoneModel{}
+	for _, dns := range matches {
+		state.DNSZones = append(state.DNSZones, newDNSZoneModel(dns))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,70 @@
+package data_sources
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+)
+
+func Test_newDNSZoneModel(t *testing.T) {
+	model := newDNSZoneModel(client.DNS{
+		ID:   "zone",
+		Name: "example.com",
+		Servers: map[string]client.Server{
+			"b": {ID: "b", Addr: "1.1.1.1", Order: 1},
+			"a": {ID: "a", Addr: "8.8.8.8", Order: 0},
+		},
+		DNS64Exclude: map[string]client.DNSExclude{
+			"y": {ID: "y", Name: "b.example.com", Order: 2},
+			"x": {ID: "x", Name: "a.example.com", Order: 1},
+		},
+	})
+
+	servers := []string{}
+	for _, server := range model.Servers {
+		servers = append(servers, server.Addr.ValueString())
+	}
+	if want := []string{"8.8.8.8", "1.1.1.1"}; !reflect.DeepEqual(servers, want) {
+		t.Errorf("newDNSZoneModel() servers = %v, want %v", servers, want)
+	}
+
+	excludes := []string{}
+	for _, exclude := range model.Excludes {
+		excludes = append(excludes, exclude.Name.ValueString())
+	}
+	if want := []string{"a.example.com", "b.example.com"}; !reflect.DeepEqual(excludes, want) {
+		t.Errorf("newDNSZoneModel() excludes = %v, want %v", excludes, want)
+	}
+
+	if model.IncludeOnlySites == nil {
+		t.Errorf("newDNSZoneModel() include_only_sites is nil, want empty")
+	}
+}
+
+func Test_newDNSBlockListModel(t *testing.T) {
+	tests := []struct {
+		name            string
+		overrideToAllow string
+		want            []string
+	}{
+		{
+			name:            "none",
+			overrideToAllow: "",
+			want:            []string{},
+		},
+		{
+			name:            "several",
+			overrideToAllow: "example.com\nexample.net\n",
+			want:            []string{"example.com", "example.net"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newDNSBlockListModel(client.DNSBlockList{OverrideToAllow: tt.overrideToAllow})
+			if !reflect.DeepEqual(got.OverrideToAllow, tt.want) {
+				t.Errorf("newDNSBlockListModel() override_to_allow = %v, want %v", got.OverrideToAllow, tt.want)
+			}
+		})
+	}
+}
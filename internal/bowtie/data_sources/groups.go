@@ -0,0 +1,153 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &groupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &groupsDataSource{}
+)
+
+func NewGroupsDataSource() datasource.DataSource {
+	return &groupsDataSource{}
+}
+
+type groupsDataSource struct {
+	client *client.Client
+}
+
+type groupsModel struct {
+	NameRegex types.String        `tfsdk:"name_regex"`
+	Groups    []groupSummaryModel `tfsdk:"groups"`
+}
+
+type groupSummaryModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+// filterGroups returns the groups whose name matches nameRegex, or every
+// group when it is nil, ordered by name and then ID.
+func filterGroups(groups map[string]client.Group, nameRegex *regexp.Regexp) []groupSummaryModel {
+	matched := []client.Group{}
+	for _, group := range groups {
+		if nameRegex != nil && !nameRegex.MatchString(group.Name) {
+			continue
+		}
+		matched = append(matched, group)
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		if matched[i].Name != matched[j].Name {
+			return matched[i].Name < matched[j].Name
+		}
+		return matched[i].ID < matched[j].ID
+	})
+
+	models := []groupSummaryModel{}
+	for _, group := range matched {
+		models = append(models, groupSummaryModel{
+			ID:   types.StringValue(group.ID),
+			Name: types.StringValue(group.Name),
+		})
+	}
+
+	return models
+}
+
+func (d *groupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_groups"
+}
+
+func (d *groupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Reference the groups of users in the organization, optionally only those whose name matches a regular expression.
+
+Use the ` + "`bowtie_group`" + ` data source to also retrieve the members of a group.
+`,
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return groups whose name matches this [regular expression](https://github.com/google/re2/wiki/Syntax). The expression isn't anchored, so use `^` and `$` to match whole names.",
+			},
+			"groups": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The groups matching `name_regex`, ordered by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Internal resource ID.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The human-readable name of the group.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *groupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configuration Type",
+			fmt.Sprintf("Expected *client.Client, got: %T, please report this to the provider.", req.ProviderData),
+		)
+	}
+
+	d.client = client
+}
+
+func (d *groupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state groupsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid regular expression",
+				"The name_regex could not be parsed: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	groups, err := d.client.ListGroups(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to retrieve groups",
+			"Unexpected error retrieving groups: "+err.Error(),
+		)
+		return
+	}
+
+	state.Groups = filterGroups(groups, nameRegex)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
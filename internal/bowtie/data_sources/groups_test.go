@@ -0,0 +1,55 @@
+package data_sources
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+)
+
+func Test_filterGroups(t *testing.T) {
+	groups := map[string]client.Group{
+		"eng":     {ID: "eng", Name: "Engineering"},
+		"eng-ops": {ID: "eng-ops", Name: "Engineering Ops"},
+		"sales":   {ID: "sales", Name: "Sales"},
+		"dup":     {ID: "dup", Name: "Sales"},
+	}
+
+	tests := []struct {
+		name      string
+		nameRegex *regexp.Regexp
+		want      []string
+	}{
+		{
+			name: "every group",
+			want: []string{"eng", "eng-ops", "dup", "sales"},
+		},
+		{
+			name:      "unanchored",
+			nameRegex: regexp.MustCompile("Engineering"),
+			want:      []string{"eng", "eng-ops"},
+		},
+		{
+			name:      "anchored",
+			nameRegex: regexp.MustCompile("^Engineering$"),
+			want:      []string{"eng"},
+		},
+		{
+			name:      "no match",
+			nameRegex: regexp.MustCompile("Marketing"),
+			want:      []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			for _, group := range filterGroups(groups, tt.nameRegex) {
+				got = append(got, group.ID.ValueString())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("filterGroups() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
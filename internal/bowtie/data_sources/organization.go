@@ -0,0 +1,128 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &organizationDataSource{}
+	_ datasource.DataSourceWithConfigure = &organizationDataSource{}
+)
+
+func NewOrganizationDataSource() datasource.DataSource {
+	return &organizationDataSource{}
+}
+
+type organizationDataSource struct {
+	client *client.Client
+}
+
+type organizationModel struct {
+	ID         types.String            `tfsdk:"id"`
+	Name       types.String            `tfsdk:"name"`
+	Domain     types.String            `tfsdk:"domain"`
+	IPV6Ranges []string                `tfsdk:"ipv6_ranges"`
+	Sites      []siteModel             `tfsdk:"sites"`
+	DNS        map[string]dnsZoneModel `tfsdk:"dns"`
+}
+
+func (o *organizationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization"
+}
+
+func (o *organizationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Reference the organization, including every site with its ranges and Controllers and every DNS zone.
+
+Unlike the ` + "`bowtie_organization`" + ` resource, the data source doesn't need to be imported, so any module can read the organization's topology.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Internal resource ID.",
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The human readable name of the organization.",
+			},
+			"domain": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The domain associated with the organization.",
+			},
+			"ipv6_ranges": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "The IPv6 ranges devices of the organization are assigned addresses from.",
+			},
+			"sites": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The sites of the organization.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: siteAttributes(),
+				},
+			},
+			"dns": schema.MapNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The DNS zones of the organization, by ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: dnsZoneAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (o *organizationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configuration Type",
+			fmt.Sprintf("Expected *client.Client, got: %T, please report this to the provider.", req.ProviderData),
+		)
+	}
+
+	o.client = client
+}
+
+func (o *organizationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	org, err := o.client.GetOrganization(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to retrieve organization",
+			"Unexpected error retrieving organization: "+err.Error(),
+		)
+		return
+	}
+
+	state := organizationModel{
+		ID:         types.StringValue(org.ID),
+		Name:       types.StringValue(org.Name),
+		Domain:     types.StringValue(org.Domain),
+		IPV6Ranges: org.IPV6Ranges,
+		Sites:      []siteModel{},
+		DNS:        map[string]dnsZoneModel{},
+	}
+	if state.IPV6Ranges == nil {
+		state.IPV6Ranges = []string{}
+	}
+
+	for _, site := range org.Sites {
+		state.Sites = append(state.Sites, newSiteModel(site))
+	}
+	for id, dns := range org.DNS {
+		state.DNS[id] = newDNSZoneModel(dns)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
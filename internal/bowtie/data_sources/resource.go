@@ -0,0 +1,201 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &resourceDataSource{}
+	_ datasource.DataSourceWithConfigure        = &resourceDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &resourceDataSource{}
+)
+
+func NewResourceDataSource() datasource.DataSource {
+	return &resourceDataSource{}
+}
+
+type resourceDataSource struct {
+	client *client.Client
+}
+
+// resourceLookupModel is a resourceModel whose location may be left out
+// of the configuration.
+type resourceLookupModel struct {
+	ID       types.String           `tfsdk:"id"`
+	Name     types.String           `tfsdk:"name"`
+	Protocol types.String           `tfsdk:"protocol"`
+	Location *resourceLocationModel `tfsdk:"location"`
+	Ports    *resourcePortsModel    `tfsdk:"ports"`
+}
+
+// describe lists the attributes the lookup was configured with, e.g.
+// `name "Wiki", protocol "https"`, for errors about the lookup.
+func (m resourceLookupModel) describe() string {
+	attributes := []struct {
+		name  string
+		value types.String
+	}{
+		{"id", m.ID},
+		{"name", m.Name},
+		{"protocol", m.Protocol},
+	}
+	if m.Location != nil {
+		attributes = append(attributes, []struct {
+			name  string
+			value types.String
+		}{
+			{"ip", m.Location.IP},
+			{"cidr", m.Location.CIDR},
+			{"dns", m.Location.DNS},
+		}...)
+	}
+
+	var parts []string
+	for _, attribute := range attributes {
+		if !attribute.value.IsNull() {
+			parts = append(parts, fmt.Sprintf("%s %q", attribute.name, attribute.value.ValueString()))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+func (d *resourceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource"
+}
+
+func (d *resourceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := resourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Internal resource ID.",
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Human readable name of the resource.",
+	}
+	attributes["protocol"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Matching connection protocol, one of `all`, `tcp`, `udp`, `http`, `https`, `icmp4` or `icmp6`.",
+	}
+	attributes["location"] = schema.SingleNestedAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The address of the resource. Set one of `ip`, `cidr` or `dns` to look the resource up by its address.",
+		Attributes: map[string]schema.Attribute{
+			"ip": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The IP address of the resource.",
+			},
+			"cidr": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The CIDR address of the resource.",
+			},
+			"dns": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The DNS name of the resource.",
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Reference a resource managed elsewhere, looked up by its ID, name, protocol or location.
+Every attribute that is set must match, and the lookup fails unless exactly one resource matches.
+`,
+		Attributes: attributes,
+	}
+}
+
+func (d *resourceDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+			path.MatchRoot("protocol"),
+			path.MatchRoot("location"),
+		),
+	}
+}
+
+func (d *resourceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configuration Type",
+			fmt.Sprintf("Expected *client.Client, got: %T, please report this to the provider.", req.ProviderData),
+		)
+	}
+
+	d.client = client
+}
+
+func (d *resourceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state resourceLookupModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := resourceFilter{
+		Name:     state.Name.ValueString(),
+		Protocol: state.Protocol.ValueString(),
+	}
+	if state.Location != nil {
+		filter.IP = state.Location.IP.ValueString()
+		filter.CIDR = state.Location.CIDR.ValueString()
+		filter.DNS = state.Location.DNS.ValueString()
+	}
+
+	policies, err := d.client.GetPoliciesAndResources(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to retrieve resource",
+			"Unexpected error retrieving resource: "+err.Error(),
+		)
+		return
+	}
+
+	resources := make([]client.BowtieResource, 0, len(policies.Resources))
+	for _, resource := range policies.Resources {
+		resources = append(resources, resource)
+	}
+
+	match, err := client.FindOne("resource with", state.describe(), resources, func(resource client.BowtieResource) bool {
+		return (state.ID.IsNull() || resource.ID == state.ID.ValueString()) && filter.matches(resource)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to retrieve resource",
+			"Unexpected error retrieving resource: "+err.Error(),
+		)
+		return
+	}
+
+	resource := newResourceModel(*match)
+	state.ID = resource.ID
+	state.Name = resource.Name
+	state.Protocol = resource.Protocol
+	state.Location = &resource.Location
+	state.Ports = &resource.Ports
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
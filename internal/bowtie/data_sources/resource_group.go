@@ -0,0 +1,114 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ datasource.DataSource              = &resourceGroupDataSource{}
+	_ datasource.DataSourceWithConfigure = &resourceGroupDataSource{}
+)
+
+func NewResourceGroupDataSource() datasource.DataSource {
+	return &resourceGroupDataSource{}
+}
+
+type resourceGroupDataSource struct {
+	client *client.Client
+}
+
+func (d *resourceGroupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_group"
+}
+
+func (d *resourceGroupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := resourceGroupAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Internal resource ID. Exactly one of `id` or `name` must be set.",
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The human readable name of the resource group. Exactly one of `id` or `name` must be set. The lookup fails if more than one resource group has this name.",
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Reference a resource group managed elsewhere, looked up by ID or name, along with every resource group and resource it includes.
+`,
+		Attributes: attributes,
+	}
+}
+
+func (d *resourceGroupDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configuration Type",
+			fmt.Sprintf("Expected *client.Client, got: %T, please report this to the provider.", req.ProviderData),
+		)
+	}
+
+	d.client = client
+}
+
+func (d *resourceGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state resourceGroupModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policies, err := d.client.GetPoliciesAndResources(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to retrieve resource group",
+			"Unexpected error retrieving resource group: "+err.Error(),
+		)
+		return
+	}
+
+	groups := make([]client.BowtieResourceGroup, 0, len(policies.ResourceGroups))
+	for _, group := range policies.ResourceGroups {
+		groups = append(groups, group)
+	}
+
+	var match *client.BowtieResourceGroup
+	if !state.ID.IsNull() {
+		match, err = client.FindOne("resource group", state.ID.ValueString(), groups, func(group client.BowtieResourceGroup) bool {
+			return group.ID == state.ID.ValueString()
+		})
+	} else {
+		match, err = client.FindOne("resource group", state.Name.ValueString(), groups, func(group client.BowtieResourceGroup) bool {
+			return group.Name == state.Name.ValueString()
+		})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to retrieve resource group",
+			"Unexpected error retrieving resource group: "+err.Error(),
+		)
+		return
+	}
+
+	state = newResourceGroupModel(*match, policies.ResourceGroups)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,227 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &resourceGroupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &resourceGroupsDataSource{}
+)
+
+func NewResourceGroupsDataSource() datasource.DataSource {
+	return &resourceGroupsDataSource{}
+}
+
+type resourceGroupsDataSource struct {
+	client *client.Client
+}
+
+type resourceGroupsModel struct {
+	NameRegex      types.String         `tfsdk:"name_regex"`
+	ResourceGroups []resourceGroupModel `tfsdk:"resource_groups"`
+}
+
+type resourceGroupModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Inherited    []string     `tfsdk:"inherited"`
+	Resources    []string     `tfsdk:"resources"`
+	AllInherited []string     `tfsdk:"all_inherited"`
+	AllResources []string     `tfsdk:"all_resources"`
+}
+
+// newResourceGroupModel converts a resource group, expanding the resource
+// groups it inherits from against every resource group in groups.
+func newResourceGroupModel(group client.BowtieResourceGroup, groups map[string]client.BowtieResourceGroup) resourceGroupModel {
+	allInherited, allResources := expandResourceGroup(group, groups)
+
+	return resourceGroupModel{
+		ID:           types.StringValue(group.ID),
+		Name:         types.StringValue(group.Name),
+		Inherited:    sortedStrings(group.Inherited),
+		Resources:    sortedStrings(group.Resources),
+		AllInherited: allInherited,
+		AllResources: allResources,
+	}
+}
+
+// expandResourceGroup walks the tree of resource groups a group inherits
+// from, returning every resource group in the tree and every resource
+// they contain, the group's own included. Cycles are visited once.
+func expandResourceGroup(group client.BowtieResourceGroup, groups map[string]client.BowtieResourceGroup) ([]string, []string) {
+	inherited := map[string]bool{}
+	resources := map[string]bool{}
+
+	var walk func(group client.BowtieResourceGroup)
+	walk = func(group client.BowtieResourceGroup) {
+		for _, id := range group.Resources {
+			resources[id] = true
+		}
+		for _, id := range group.Inherited {
+			if inherited[id] {
+				continue
+			}
+			inherited[id] = true
+			if child, ok := groups[id]; ok {
+				walk(child)
+			}
+		}
+	}
+	inherited[group.ID] = true
+	walk(group)
+	delete(inherited, group.ID)
+
+	return sortedKeys(inherited), sortedKeys(resources)
+}
+
+func sortedStrings(values []string) []string {
+	sorted := append([]string{}, values...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := []string{}
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// resourceGroupAttributes describes a resource group, as reported by both
+// the bowtie_resource_group and bowtie_resource_groups data sources.
+func resourceGroupAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Internal resource ID.",
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The human readable name of the resource group.",
+		},
+		"inherited": schema.ListAttribute{
+			ElementType:         types.StringType,
+			Computed:            true,
+			MarkdownDescription: "The IDs of the resource groups directly included in this resource group.",
+		},
+		"resources": schema.ListAttribute{
+			ElementType:         types.StringType,
+			Computed:            true,
+			MarkdownDescription: "The IDs of the resources directly included in this resource group.",
+		},
+		"all_inherited": schema.ListAttribute{
+			ElementType:         types.StringType,
+			Computed:            true,
+			MarkdownDescription: "The IDs of every resource group included in this resource group, directly or through other resource groups.",
+		},
+		"all_resources": schema.ListAttribute{
+			ElementType:         types.StringType,
+			Computed:            true,
+			MarkdownDescription: "The IDs of every resource included in this resource group, directly or through the resource groups it includes.",
+		},
+	}
+}
+
+func (d *resourceGroupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_groups"
+}
+
+func (d *resourceGroupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Reference the resource groups of the organization, optionally only those whose name matches a regular expression.
+`,
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return resource groups whose name matches this [regular expression](https://github.com/google/re2/wiki/Syntax). The expression isn't anchored, so use `^` and `$` to match whole names.",
+			},
+			"resource_groups": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource groups matching `name_regex`, ordered by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: resourceGroupAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *resourceGroupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configuration Type",
+			fmt.Sprintf("Expected *client.Client, got: %T, please report this to the provider.", req.ProviderData),
+		)
+	}
+
+	d.client = client
+}
+
+func (d *resourceGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state resourceGroupsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid regular expression",
+				"The name_regex could not be parsed: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	policies, err := d.client.GetPoliciesAndResources(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to retrieve resource groups",
+			"Unexpected error retrieving resource groups: "+err.Error(),
+		)
+		return
+	}
+
+	matches := []client.BowtieResourceGroup{}
+	for _, group := range policies.ResourceGroups {
+		if nameRegex == nil || nameRegex.MatchString(group.Name) {
+			matches = append(matches, group)
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Name != matches[j].Name {
+			return matches[i].Name < matches[j].Name
+		}
+		return matches[i].ID < matches[j].ID
+	})
+
+	state.ResourceGroups = []resourceGroupModel{}
+	for _, group := range matches {
+		state.ResourceGroups = append(state.ResourceGroups, newResourceGroupModel(group, policies.ResourceGroups))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,271 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &resourcesDataSource{}
+	_ datasource.DataSourceWithConfigure = &resourcesDataSource{}
+)
+
+func NewResourcesDataSource() datasource.DataSource {
+	return &resourcesDataSource{}
+}
+
+type resourcesDataSource struct {
+	client *client.Client
+}
+
+type resourcesModel struct {
+	Name      types.String    `tfsdk:"name"`
+	Protocol  types.String    `tfsdk:"protocol"`
+	IP        types.String    `tfsdk:"ip"`
+	CIDR      types.String    `tfsdk:"cidr"`
+	DNS       types.String    `tfsdk:"dns"`
+	Resources []resourceModel `tfsdk:"resources"`
+}
+
+type resourceModel struct {
+	ID       types.String          `tfsdk:"id"`
+	Name     types.String          `tfsdk:"name"`
+	Protocol types.String          `tfsdk:"protocol"`
+	Location resourceLocationModel `tfsdk:"location"`
+	Ports    resourcePortsModel    `tfsdk:"ports"`
+}
+
+type resourceLocationModel struct {
+	IP   types.String `tfsdk:"ip"`
+	CIDR types.String `tfsdk:"cidr"`
+	DNS  types.String `tfsdk:"dns"`
+}
+
+type resourcePortsModel struct {
+	Range      []int64 `tfsdk:"range"`
+	Collection []int64 `tfsdk:"collection"`
+}
+
+// newResourceModel converts a resource. Location kinds and port lists the
+// resource doesn't use are null, as they are in the bowtie_resource
+// resource.
+func newResourceModel(resource client.BowtieResource) resourceModel {
+	optional := func(value string) types.String {
+		if value == "" {
+			return types.StringNull()
+		}
+		return types.StringValue(value)
+	}
+
+	model := resourceModel{
+		ID:       types.StringValue(resource.ID),
+		Name:     types.StringValue(resource.Name),
+		Protocol: types.StringValue(resource.Protocol),
+		Location: resourceLocationModel{
+			IP:   optional(resource.Location.IP),
+			CIDR: optional(resource.Location.CIDR),
+			DNS:  optional(resource.Location.DNS),
+		},
+	}
+
+	if len(resource.Ports.Range) > 0 {
+		model.Ports.Range = resource.Ports.Range
+	}
+	if resource.Ports.Collection != nil && len(resource.Ports.Collection.Ports) > 0 {
+		model.Ports.Collection = resource.Ports.Collection.Ports
+	}
+
+	return model
+}
+
+// resourceAttributes describes a resource, as reported by both the
+// bowtie_resource and bowtie_resources data sources.
+func resourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Internal resource ID.",
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Human readable name of the resource.",
+		},
+		"protocol": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Matching connection protocol.",
+		},
+		"location": schema.SingleNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "The address of the resource. Exactly one of `ip`, `cidr` or `dns` is set.",
+			Attributes: map[string]schema.Attribute{
+				"ip": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The IP address of the resource.",
+				},
+				"cidr": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The CIDR address of the resource.",
+				},
+				"dns": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The DNS name of the resource.",
+				},
+			},
+		},
+		"ports": schema.SingleNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "Which ports the resource includes. Exactly one of `range` or `collection` is set.",
+			Attributes: map[string]schema.Attribute{
+				"range": schema.ListAttribute{
+					ElementType:         types.Int64Type,
+					Computed:            true,
+					MarkdownDescription: "The low and high port of an inclusive range of ports.",
+				},
+				"collection": schema.ListAttribute{
+					ElementType:         types.Int64Type,
+					Computed:            true,
+					MarkdownDescription: "List of allowed ports.",
+				},
+			},
+		},
+	}
+}
+
+// resourceFilter selects resources by their attributes. Empty fields
+// match any resource.
+type resourceFilter struct {
+	Name     string
+	Protocol string
+	IP       string
+	CIDR     string
+	DNS      string
+}
+
+// apply returns the resources matching the filter, ordered by name and ID.
+func (f resourceFilter) apply(resources map[string]client.BowtieResource) []client.BowtieResource {
+	matches := []client.BowtieResource{}
+	for _, resource := range resources {
+		if f.matches(resource) {
+			matches = append(matches, resource)
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Name != matches[j].Name {
+			return matches[i].Name < matches[j].Name
+		}
+		return matches[i].ID < matches[j].ID
+	})
+
+	return matches
+}
+
+func (f resourceFilter) matches(resource client.BowtieResource) bool {
+	for _, field := range []struct{ want, got string }{
+		{f.Name, resource.Name},
+		{f.Protocol, resource.Protocol},
+		{f.IP, resource.Location.IP},
+		{f.CIDR, resource.Location.CIDR},
+		{f.DNS, resource.Location.DNS},
+	} {
+		if field.want != "" && field.want != field.got {
+			return false
+		}
+	}
+
+	return true
+}
+
+// resourceFilterAttributes describes the attributes resources can be
+// looked up by.
+var resourceFilterAttributes = map[string]string{
+	"name":     "the human readable name",
+	"protocol": "the protocol, one of `all`, `tcp`, `udp`, `http`, `https`, `icmp4` or `icmp6`",
+	"ip":       "the IP address",
+	"cidr":     "the CIDR address",
+	"dns":      "the DNS name",
+}
+
+func (d *resourcesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resources"
+}
+
+func (d *resourcesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"resources": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "The resources matching the filters, ordered by name.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: resourceAttributes(),
+			},
+		},
+	}
+	for name, description := range resourceFilterAttributes {
+		attributes[name] = schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: fmt.Sprintf("Only return resources with %s.", description),
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Reference the resources of the organization, for example to collect resources managed in another configuration into a resource group.
+All of the filters are optional, and a resource must match every filter that is set to be returned.
+`,
+		Attributes: attributes,
+	}
+}
+
+func (d *resourcesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configuration Type",
+			fmt.Sprintf("Expected *client.Client, got: %T, please report this to the provider.", req.ProviderData),
+		)
+	}
+
+	d.client = client
+}
+
+func (d *resourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state resourcesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := resourceFilter{
+		Name:     state.Name.ValueString(),
+		Protocol: state.Protocol.ValueString(),
+		IP:       state.IP.ValueString(),
+		CIDR:     state.CIDR.ValueString(),
+		DNS:      state.DNS.ValueString(),
+	}
+
+	policies, err := d.client.GetPoliciesAndResources(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to retrieve resources",
+			"Unexpected error retrieving resources: "+err.Error(),
+		)
+		return
+	}
+
+	state.Resources = []resourceModel{}
+	for _, resource := range filter.apply(policies.Resources) {
+		state.Resources = append(state.Resources, newResourceModel(resource))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
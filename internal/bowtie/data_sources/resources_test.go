@@ -0,0 +1,115 @@
+package data_sources
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+)
+
+func Test_resourceFilter_apply(t *testing.T) {
+	resources := map[string]client.BowtieResource{
+		"wiki": {
+			ID:       "wiki",
+			Name:     "Wiki",
+			Protocol: "https",
+			Location: client.BowtieResourceLocation{DNS: "wiki.example.com"},
+		},
+		"lan": {
+			ID:       "lan",
+			Name:     "LAN",
+			Protocol: "all",
+			Location: client.BowtieResourceLocation{CIDR: "10.0.0.0/8"},
+		},
+		"printer": {
+			ID:       "printer",
+			Name:     "Printer",
+			Protocol: "tcp",
+			Location: client.BowtieResourceLocation{IP: "10.0.0.5"},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		filter resourceFilter
+		want   []string
+	}{
+		{
+			name:   "no filters",
+			filter: resourceFilter{},
+			want:   []string{"lan", "printer", "wiki"},
+		},
+		{
+			name:   "name",
+			filter: resourceFilter{Name: "Wiki"},
+			want:   []string{"wiki"},
+		},
+		{
+			name:   "cidr",
+			filter: resourceFilter{CIDR: "10.0.0.0/8"},
+			want:   []string{"lan"},
+		},
+		{
+			name:   "every filter must match",
+			filter: resourceFilter{Protocol: "tcp", DNS: "wiki.example.com"},
+			want:   []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			for _, resource := range tt.filter.apply(resources) {
+				got = append(got, resource.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resourceFilter.apply() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_expandResourceGroup(t *testing.T) {
+	groups := map[string]client.BowtieResourceGroup{
+		"all":    {ID: "all", Inherited: []string{"tools", "office"}, Resources: []string{"vpn"}},
+		"tools":  {ID: "tools", Inherited: []string{"docs"}, Resources: []string{"ci"}},
+		"docs":   {ID: "docs", Resources: []string{"wiki", "ci"}},
+		"office": {ID: "office", Inherited: []string{"all", "missing"}, Resources: []string{"printer"}},
+	}
+
+	tests := []struct {
+		name          string
+		group         string
+		wantInherited []string
+		wantResources []string
+	}{
+		{
+			name:          "leaf",
+			group:         "docs",
+			wantInherited: []string{},
+			wantResources: []string{"ci", "wiki"},
+		},
+		{
+			name:          "nested",
+			group:         "tools",
+			wantInherited: []string{"docs"},
+			wantResources: []string{"ci", "wiki"},
+		},
+		{
+			name:          "cycle and unknown group",
+			group:         "all",
+			wantInherited: []string{"docs", "missing", "office", "tools"},
+			wantResources: []string{"ci", "printer", "vpn", "wiki"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotInherited, gotResources := expandResourceGroup(groups[tt.group], groups)
+			if !reflect.DeepEqual(gotInherited, tt.wantInherited) {
+				t.Errorf("expandResourceGroup() inherited = %v, want %v", gotInherited, tt.wantInherited)
+			}
+			if !reflect.DeepEqual(gotResources, tt.wantResources) {
+				t.Errorf("expandResourceGroup() resources = %v, want %v", gotResources, tt.wantResources)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ datasource.DataSource              = &siteDataSource{}
+	_ datasource.DataSourceWithConfigure = &siteDataSource{}
+)
+
+func NewSiteDataSource() datasource.DataSource {
+	return &siteDataSource{}
+}
+
+type siteDataSource struct {
+	client *client.Client
+}
+
+func (d *siteDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site"
+}
+
+func (d *siteDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := siteAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Internal resource ID. Exactly one of `id` or `name` must be set.",
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The human readable name of the site. Exactly one of `id` or `name` must be set. The lookup fails if more than one site has this name.",
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Reference a site, looked up by ID or name, along with its routable ranges and Controllers.
+`,
+		Attributes: attributes,
+	}
+}
+
+func (d *siteDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configuration Type",
+			fmt.Sprintf("Expected *client.Client, got: %T, please report this to the provider.", req.ProviderData),
+		)
+	}
+
+	d.client = client
+}
+
+func (d *siteDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state siteModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var site *client.Site
+	var err error
+	if !state.ID.IsNull() {
+		site, err = d.client.GetSite(ctx, state.ID.ValueString())
+	} else {
+		site, err = d.client.GetSiteByName(ctx, state.Name.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to retrieve site",
+			"Unexpected error retrieving site: "+err.Error(),
+		)
+		return
+	}
+
+	state = newSiteModel(*site)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
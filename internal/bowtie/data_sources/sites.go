@@ -0,0 +1,233 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &sitesDataSource{}
+	_ datasource.DataSourceWithConfigure = &sitesDataSource{}
+)
+
+func NewSitesDataSource() datasource.DataSource {
+	return &sitesDataSource{}
+}
+
+type sitesDataSource struct {
+	client *client.Client
+}
+
+type sitesModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+	Sites     []siteModel  `tfsdk:"sites"`
+}
+
+type siteModel struct {
+	ID          types.String      `tfsdk:"id"`
+	Name        types.String      `tfsdk:"name"`
+	IPV4Ranges  []siteRangeModel  `tfsdk:"ipv4_ranges"`
+	IPV6Ranges  []siteRangeModel  `tfsdk:"ipv6_ranges"`
+	Controllers []controllerModel `tfsdk:"controllers"`
+}
+
+type siteRangeModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Range       types.String `tfsdk:"range"`
+	Weight      types.Int64  `tfsdk:"weight"`
+	Metric      types.Int64  `tfsdk:"metric"`
+}
+
+func newSiteModel(site client.Site) siteModel {
+	model := siteModel{
+		ID:          types.StringValue(site.ID),
+		Name:        types.StringValue(site.Name),
+		IPV4Ranges:  []siteRangeModel{},
+		IPV6Ranges:  []siteRangeModel{},
+		Controllers: []controllerModel{},
+	}
+
+	for _, r := range site.RoutableRangesV4 {
+		model.IPV4Ranges = append(model.IPV4Ranges, newSiteRangeModel(r))
+	}
+	for _, r := range site.RouteRangesV6 {
+		model.IPV6Ranges = append(model.IPV6Ranges, newSiteRangeModel(r))
+	}
+	for _, controller := range site.Controllers {
+		if controller.SiteID == "" {
+			controller.SiteID = site.ID
+		}
+		model.Controllers = append(model.Controllers, newControllerModel(controller))
+	}
+
+	return model
+}
+
+func newSiteRangeModel(r client.RoutableRange) siteRangeModel {
+	return siteRangeModel{
+		ID:          types.StringValue(r.ID),
+		Name:        types.StringValue(r.Name),
+		Description: types.StringValue(r.Description),
+		Range:       types.StringValue(r.Range),
+		Weight:      types.Int64Value(r.Weight),
+		Metric:      types.Int64Value(r.Metric),
+	}
+}
+
+// siteAttributes describes a site along with its ranges and Controllers.
+func siteAttributes() map[string]schema.Attribute {
+	siteRange := schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Internal resource ID.",
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The human readable name of the range.",
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "A description of the range.",
+			},
+			"range": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The CIDR of the range.",
+			},
+			"weight": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The weight of the range when several sites route the same addresses.",
+			},
+			"metric": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The metric of the range when several sites route the same addresses.",
+			},
+		},
+	}
+
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Internal resource ID.",
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The human readable name of the site.",
+		},
+		"ipv4_ranges": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "The IPv4 ranges the site routes.",
+			NestedObject:        siteRange,
+		},
+		"ipv6_ranges": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "The IPv6 ranges the site routes.",
+			NestedObject:        siteRange,
+		},
+		"controllers": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "The Controllers of the site.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: controllerAttributes(),
+			},
+		},
+	}
+}
+func (d *sitesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sites"
+}
+
+func (d *sitesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Reference the sites of the organization along with their routable ranges and Controllers, optionally only those whose name matches a regular expression.
+`,
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return sites whose name matches this [regular expression](https://github.com/google/re2/wiki/Syntax). The expression isn't anchored, so use `^` and `$` to match whole names.",
+			},
+			"sites": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The sites matching `name_regex`, ordered by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: siteAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *sitesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configuration Type",
+			fmt.Sprintf("Expected *client.Client, got: %T, please report this to the provider.", req.ProviderData),
+		)
+	}
+
+	d.client = client
+}
+
+func (d *sitesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state sitesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid regular expression",
+				"The name_regex could not be parsed: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	sites, err := d.client.ListSites(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to retrieve sites",
+			"Unexpected error retrieving sites: "+err.Error(),
+		)
+		return
+	}
+
+	sort.Slice(sites, func(i, j int) bool {
+		if sites[i].Name != sites[j].Name {
+			return sites[i].Name < sites[j].Name
+		}
+		return sites[i].ID < sites[j].ID
+	})
+
+	state.Sites = []siteModel{}
+	for _, site := range sites {
+		if nameRegex != nil && !nameRegex.MatchString(site.Name) {
+			continue
+		}
+		state.Sites = append(state.Sites, newSiteModel(site))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,292 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &usersDataSource{}
+	_ datasource.DataSourceWithConfigure = &usersDataSource{}
+)
+
+func NewUsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+type usersDataSource struct {
+	client *client.Client
+}
+
+type usersModel struct {
+	Role              types.String       `tfsdk:"role"`
+	Status            types.String       `tfsdk:"status"`
+	EmailDomain       types.String       `tfsdk:"email_domain"`
+	EmailRegex        types.String       `tfsdk:"email_regex"`
+	AuthzDevices      types.Bool         `tfsdk:"authz_devices"`
+	AuthzPolicies     types.Bool         `tfsdk:"authz_policies"`
+	AuthzControlPlane types.Bool         `tfsdk:"authz_control_plane"`
+	AuthzUsers        types.Bool         `tfsdk:"authz_users"`
+	Users             []userSummaryModel `tfsdk:"users"`
+}
+
+type userSummaryModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Email             types.String `tfsdk:"email"`
+	Role              types.String `tfsdk:"role"`
+	Status            types.String `tfsdk:"status"`
+	AuthzDevices      types.Bool   `tfsdk:"authz_devices"`
+	AuthzPolicies     types.Bool   `tfsdk:"authz_policies"`
+	AuthzControlPlane types.Bool   `tfsdk:"authz_control_plane"`
+	AuthzUsers        types.Bool   `tfsdk:"authz_users"`
+}
+
+func newUserSummaryModel(user client.BowtieUser) userSummaryModel {
+	return userSummaryModel{
+		ID:                types.StringValue(user.ID),
+		Name:              types.StringValue(user.Name),
+		Email:             types.StringValue(user.Email),
+		Role:              types.StringValue(user.Role),
+		Status:            types.StringValue(user.Status),
+		AuthzDevices:      types.BoolValue(isSet(user.AuthzDevices)),
+		AuthzPolicies:     types.BoolValue(isSet(user.AuthzPolicies)),
+		AuthzControlPlane: types.BoolValue(isSet(user.AuthzControlPlane)),
+		AuthzUsers:        types.BoolValue(isSet(user.AuthzUsers)),
+	}
+}
+
+// isSet reports whether an authorization flag is granted. The API omits
+// flags that aren't.
+func isSet(flag *bool) bool {
+	return flag != nil && *flag
+}
+
+func (u *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (u *usersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	authz := map[string]string{
+		"authz_devices":       "administer organization devices",
+		"authz_policies":      "administer organization policies",
+		"authz_control_plane": "administer an organization's control plane configuration",
+		"authz_users":         "update an organization's users",
+	}
+
+	users := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Internal resource ID.",
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The given name for a user.",
+		},
+		"email": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Identifying login address.",
+		},
+		"role": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "What role the user is assigned, one of `Owner`, `User`, `FullAdministrator` or `LimitedAdministrator`.",
+		},
+		"status": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Represents the user's current system state. Can be `active` or `disabled`.",
+		},
+	}
+
+	attributes := map[string]schema.Attribute{
+		"role": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Only return users with this role, one of `Owner`, `User`, `FullAdministrator` or `LimitedAdministrator`.",
+		},
+		"status": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Only return users in this state, `active` or `disabled`.",
+		},
+		"email_domain": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Only return users whose email address is at this domain, such as `example.com`. The comparison ignores case.",
+		},
+		"email_regex": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Only return users whose email address matches this [regular expression](https://github.com/google/re2/wiki/Syntax). The expression isn't anchored, so use `^` and `$` to match whole addresses.",
+		},
+		"users": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "The users matching the filters, ordered by email address.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: users,
+			},
+		},
+	}
+
+	for name, action := range authz {
+		attributes[name] = schema.BoolAttribute{
+			Optional:            true,
+			MarkdownDescription: fmt.Sprintf("Only return users that are, or when `false` aren't, authorized to %s.", action),
+		}
+		users[name] = schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("Whether the given user is authorized to %s.", action),
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Reference the users of the organization, for example to manage the membership of a group declaratively.
+All of the filters are optional, and a user must match every filter that is set to be returned.
+`,
+		Attributes: attributes,
+	}
+}
+
+func (u *usersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configuration Type",
+			fmt.Sprintf("Expected *client.Client, got: %T, please report this to the provider.", req.ProviderData),
+		)
+	}
+
+	u.client = client
+}
+
+func (u *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state usersModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := userFilter{
+		Role:              state.Role.ValueString(),
+		Status:            state.Status.ValueString(),
+		EmailDomain:       state.EmailDomain.ValueString(),
+		AuthzDevices:      boolPointer(state.AuthzDevices),
+		AuthzPolicies:     boolPointer(state.AuthzPolicies),
+		AuthzControlPlane: boolPointer(state.AuthzControlPlane),
+		AuthzUsers:        boolPointer(state.AuthzUsers),
+	}
+
+	if !state.EmailRegex.IsNull() {
+		var err error
+		filter.EmailRegex, err = regexp.Compile(state.EmailRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("email_regex"),
+				"Invalid regular expression",
+				"The email_regex could not be parsed: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	users, err := u.client.GetUsers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to retrieve users",
+			"Unexpected error retrieving users: "+err.Error(),
+		)
+		return
+	}
+
+	state.Users = []userSummaryModel{}
+	for _, user := range filter.apply(users) {
+		state.Users = append(state.Users, newUserSummaryModel(user))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// userFilter selects users by their attributes. Empty or nil fields match
+// any user.
+type userFilter struct {
+	Role              string
+	Status            string
+	EmailDomain       string
+	EmailRegex        *regexp.Regexp
+	AuthzDevices      *bool
+	AuthzPolicies     *bool
+	AuthzControlPlane *bool
+	AuthzUsers        *bool
+}
+
+// apply returns the users matching the filter, ordered by email and ID.
+func (f userFilter) apply(users map[string]client.BowtieUser) []client.BowtieUser {
+	matches := []client.BowtieUser{}
+	for _, user := range users {
+		if f.matches(user) {
+			matches = append(matches, user)
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Email != matches[j].Email {
+			return matches[i].Email < matches[j].Email
+		}
+		return matches[i].ID < matches[j].ID
+	})
+
+	return matches
+}
+
+func (f userFilter) matches(user client.BowtieUser) bool {
+	if f.Role != "" && f.Role != user.Role {
+		return false
+	}
+	if f.Status != "" && f.Status != user.Status {
+		return false
+	}
+
+	if f.EmailDomain != "" {
+		at := strings.LastIndex(user.Email, "@")
+		if at < 0 || !strings.EqualFold(user.Email[at+1:], strings.TrimPrefix(f.EmailDomain, "@")) {
+			return false
+		}
+	}
+	if f.EmailRegex != nil && !f.EmailRegex.MatchString(user.Email) {
+		return false
+	}
+
+	for _, flag := range []struct {
+		want *bool
+		got  *bool
+	}{
+		{f.AuthzDevices, user.AuthzDevices},
+		{f.AuthzPolicies, user.AuthzPolicies},
+		{f.AuthzControlPlane, user.AuthzControlPlane},
+		{f.AuthzUsers, user.AuthzUsers},
+	} {
+		if flag.want != nil && *flag.want != isSet(flag.got) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func boolPointer(value types.Bool) *bool {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	b := value.ValueBool()
+	return &b
+}
@@ -0,0 +1,98 @@
+package data_sources
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+)
+
+func Test_userFilter_apply(t *testing.T) {
+	yes, no := true, false
+
+	users := map[string]client.BowtieUser{
+		"owner": {
+			ID:                "owner",
+			Email:             "owner@example.com",
+			Role:              "Owner",
+			Status:            "active",
+			AuthzDevices:      &yes,
+			AuthzPolicies:     &yes,
+			AuthzControlPlane: &yes,
+			AuthzUsers:        &yes,
+		},
+		"alex": {
+			ID:           "alex",
+			Email:        "alex@Example.com",
+			Role:         "User",
+			Status:       "active",
+			AuthzDevices: &no,
+		},
+		"sam": {
+			ID:     "sam",
+			Email:  "sam@example.com",
+			Role:   "User",
+			Status: "disabled",
+		},
+		"contractor": {
+			ID:     "contractor",
+			Email:  "contractor@example.com.partner.net",
+			Role:   "User",
+			Status: "active",
+		},
+	}
+
+	tests := []struct {
+		name   string
+		filter userFilter
+		want   []string
+	}{
+		{
+			name:   "no filters",
+			filter: userFilter{},
+			want:   []string{"alex", "contractor", "owner", "sam"},
+		},
+		{
+			name:   "active users at a domain",
+			filter: userFilter{Status: "active", EmailDomain: "example.com"},
+			want:   []string{"alex", "owner"},
+		},
+		{
+			name:   "domain with @",
+			filter: userFilter{EmailDomain: "@EXAMPLE.com"},
+			want:   []string{"alex", "owner", "sam"},
+		},
+		{
+			name:   "role",
+			filter: userFilter{Role: "User"},
+			want:   []string{"alex", "contractor", "sam"},
+		},
+		{
+			name:   "email regex",
+			filter: userFilter{EmailRegex: regexp.MustCompile("^(alex|sam)@")},
+			want:   []string{"alex", "sam"},
+		},
+		{
+			name:   "authorized",
+			filter: userFilter{AuthzDevices: &yes},
+			want:   []string{"owner"},
+		},
+		{
+			name:   "unauthorized includes omitted flags",
+			filter: userFilter{AuthzUsers: &no},
+			want:   []string{"alex", "contractor", "sam"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			for _, user := range tt.filter.apply(users) {
+				got = append(got, user.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("userFilter.apply() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
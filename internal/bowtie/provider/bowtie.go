@@ -2,26 +2,57 @@ package provider
 
 import (
 	"context"
+	"crypto/tls"
+	"fmt"
+	"math"
 	"os"
+	"time"
 
 	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
 	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/data_sources"
 	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/resources"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type BowtieProvider struct{}
 
 type bowtieProviderModel struct {
-	Host               types.String `tfsdk:"host"`
-	Username           types.String `tfsdk:"username"`
-	Password           types.String `tfsdk:"password"`
-	LazyAuthentication types.Bool   `tfsdk:"lazy_authentication"`
+	Host                types.String `tfsdk:"host"`
+	Hosts               types.List   `tfsdk:"hosts"`
+	DiscoverControllers types.Bool   `tfsdk:"discover_controllers"`
+	Username            types.String `tfsdk:"username"`
+	Password            types.String `tfsdk:"password"`
+	APIToken            types.String `tfsdk:"api_token"`
+	LazyAuthentication  types.Bool   `tfsdk:"lazy_authentication"`
+	HTTPTimeout         types.String `tfsdk:"http_timeout"`
+
+	MaxRetries           types.Int64  `tfsdk:"max_retries"`
+	RetryMinBackoff      types.String `tfsdk:"retry_min_backoff"`
+	RetryMaxBackoff      types.String `tfsdk:"retry_max_backoff"`
+	RetryableStatusCodes types.List   `tfsdk:"retryable_status_codes"`
+
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+
+	CACertificate         types.String `tfsdk:"ca_certificate"`
+	CACertificateFile     types.String `tfsdk:"ca_certificate_file"`
+	ClientCertificate     types.String `tfsdk:"client_certificate"`
+	ClientCertificateFile types.String `tfsdk:"client_certificate_file"`
+	ClientKey             types.String `tfsdk:"client_key"`
+	ClientKeyFile         types.String `tfsdk:"client_key_file"`
+	TLSMinVersion         types.String `tfsdk:"tls_min_version"`
+	InsecureSkipVerify    types.Bool   `tfsdk:"insecure_skip_verify"`
 }
 
 func New() provider.Provider {
@@ -41,6 +72,19 @@ For more documentation about installing and configuring Bowtie, refer to the off
 				Description: "The Bowtie HTTP Controller endpoint. Honors the `BOWTIE_HOST` environment variable if set. Example: `https://bowtie.example.com`",
 				Optional:    true,
 			},
+			"hosts": schema.ListAttribute{
+				Description: "An ordered list of Bowtie HTTP Controller endpoints, as an alternative to `host` for organizations running several Controllers. Requests go to the first endpoint and fail over to the next one whenever a Controller is unreachable or responds with a server error.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ConflictsWith(path.MatchRoot("host")),
+				},
+			},
+			"discover_controllers": schema.BoolAttribute{
+				Description: "Add the HTTPS endpoint of every Controller in the organization to the endpoints to fail over to, whenever the organization is read from the API. Defaults to `false`.",
+				Optional:    true,
+			},
 			"username": schema.StringAttribute{
 				Description: "Administrator username/email login credentials. Honors the `BOWTIE_USERNAME` environment variable if set",
 				Optional:    true,
@@ -50,10 +94,112 @@ For more documentation about installing and configuring Bowtie, refer to the off
 				Sensitive:   true,
 				Optional:    true,
 			},
+			"api_token": schema.StringAttribute{
+				Description: "API token to authenticate with instead of a username and password, for example in CI where no administrator should have to share their password. Honors the `BOWTIE_API_TOKEN` environment variable if set. An explicitly configured `username` and `password` take precedence over the environment variable.",
+				Sensitive:   true,
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("username"), path.MatchRoot("password")),
+				},
+			},
 			"lazy_authentication": schema.BoolAttribute{
 				Description: "By default, the provider will authenticate to the Bowtie API just in time (or lazily) which permits use cases like creating Controllers in Terraform before using their API endpoints. Set this variable to `false` if you instead want to authenticate at the time the provider is configured - for example, to catch authentication errors up-front before starting an `apply` or `plan`.",
 				Optional:    true,
 			},
+			"http_timeout": schema.StringAttribute{
+				Description: "How long a single request to the Bowtie API may take, as a duration such as `10s` or `1m`. This applies to each attempt of a request, including on resources with a `timeouts` block, which bounds the whole operation including retries. Block list creates and updates are the exception: each of their requests may take up to the whole `create` or `update` timeout, since the controller fetches the upstream list before answering. Otherwise, raise it if single requests, such as large upserts, need longer. Set to `0s` to disable the timeout. Defaults to `10s`.",
+				Optional:    true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "How many times a request that failed for a transient reason, such as a network error, the API rate limiting requests with `429` or a controller briefly returning `502`, `503` or `504`, is retried before giving up. Set to `0` to disable retries. Defaults to `3`.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_min_backoff": schema.StringAttribute{
+				Description: "How long to wait before the first retry of a failed request, as a duration such as `500ms` or `2s`. The wait doubles with every following retry. Defaults to `1s`.",
+				Optional:    true,
+			},
+			"retry_max_backoff": schema.StringAttribute{
+				Description: "The longest the provider waits between two attempts of a failed request, as a duration such as `30s` or `1m`. Defaults to `30s`.",
+				Optional:    true,
+			},
+			"retryable_status_codes": schema.ListAttribute{
+				Description: "The HTTP status codes returned by the Bowtie API that are retried. Defaults to `[429, 502, 503, 504]`.",
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.ValueInt64sAre(int64validator.Between(100, 599)),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Description: "The average number of requests per second the provider sends to the Bowtie API, shared by all resources and data sources. Whenever the API responds with `429` and a `Retry-After` header, all requests are held back for as long as it asks regardless. Defaults to no limit.",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: "The largest number of requests the provider has in flight to the Bowtie API at once. Defaults to no limit.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"ca_certificate": schema.StringAttribute{
+				Description: "PEM encoded CA certificates to trust in addition to the system roots when connecting to the Controller, for Controllers using a private CA or a self-signed certificate.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_certificate_file")),
+				},
+			},
+			"ca_certificate_file": schema.StringAttribute{
+				Description: "Path to a file of PEM encoded CA certificates, as an alternative to `ca_certificate`.",
+				Optional:    true,
+			},
+			"client_certificate": schema.StringAttribute{
+				Description: "PEM encoded client certificate presented to Controllers that require mutual TLS. Requires `client_key` or `client_key_file`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_certificate_file")),
+					stringvalidator.AtLeastOneOf(path.MatchRoot("client_key"), path.MatchRoot("client_key_file")),
+				},
+			},
+			"client_certificate_file": schema.StringAttribute{
+				Description: "Path to a PEM encoded client certificate, as an alternative to `client_certificate`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("client_key"), path.MatchRoot("client_key_file")),
+				},
+			},
+			"client_key": schema.StringAttribute{
+				Description: "PEM encoded private key of the client certificate.",
+				Sensitive:   true,
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_key_file")),
+					stringvalidator.AtLeastOneOf(path.MatchRoot("client_certificate"), path.MatchRoot("client_certificate_file")),
+				},
+			},
+			"client_key_file": schema.StringAttribute{
+				Description: "Path to the PEM encoded private key of the client certificate, as an alternative to `client_key`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("client_certificate"), path.MatchRoot("client_certificate_file")),
+				},
+			},
+			"tls_min_version": schema.StringAttribute{
+				Description: "The minimum TLS version accepted when connecting to the Controller, one of `1.0`, `1.1`, `1.2` or `1.3`. Defaults to `1.2`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.TLSVersions()...),
+				},
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Skip verification of the Controller's TLS certificate. This leaves the connection, including credentials, open to interception and should only be used for testing; prefer `ca_certificate` for self-signed certificates.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -82,6 +228,14 @@ func (b *BowtieProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		)
 	}
 
+	if config.Hosts.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("hosts"),
+			"Unknown Bowtie API Hosts",
+			"The provider cannot create the Bowtie API Client as the hosts value is unknown",
+		)
+	}
+
 	if config.Username.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("username"),
@@ -98,6 +252,14 @@ func (b *BowtieProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		)
 	}
 
+	if config.APIToken.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_token"),
+			"Unknown Bowtie API Token",
+			"The provider cannot create the Bowtie API Client as the api_token value is unknown",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -105,11 +267,21 @@ func (b *BowtieProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	host := os.Getenv("BOWTIE_HOST")
 	username := os.Getenv("BOWTIE_USERNAME")
 	password := os.Getenv("BOWTIE_PASSWORD")
+	apiToken := os.Getenv("BOWTIE_API_TOKEN")
 
 	if !config.Host.IsNull() {
 		host = config.Host.ValueString()
 	}
 
+	var failoverHosts []string
+	if !config.Hosts.IsNull() {
+		var hosts []string
+		resp.Diagnostics.Append(config.Hosts.ElementsAs(ctx, &hosts, false)...)
+		if len(hosts) > 0 {
+			host, failoverHosts = hosts[0], hosts[1:]
+		}
+	}
+
 	if !config.Username.IsNull() {
 		username = config.Username.ValueString()
 	}
@@ -118,27 +290,37 @@ func (b *BowtieProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		password = config.Password.ValueString()
 	}
 
+	if !config.APIToken.IsNull() {
+		apiToken = config.APIToken.ValueString()
+	}
+
+	// A token from the environment shouldn't override a username and
+	// password configured explicitly for this provider.
+	if !config.Username.IsNull() || !config.Password.IsNull() {
+		apiToken = ""
+	}
+
 	if host == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("host"),
 			"Missing Bowtie API Host",
-			"The provider cannot create the Bowtie API client without a host being set",
+			"The provider cannot create the Bowtie API client without a host or hosts being set",
 		)
 	}
 
-	if username == "" {
+	if username == "" && apiToken == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("username"),
 			"Missing Bowtie API Username",
-			"The provider cannot create the Bowtie API Client without a username",
+			"The provider cannot create the Bowtie API Client without a username or api_token",
 		)
 	}
 
-	if password == "" {
+	if password == "" && apiToken == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("password"),
 			"Missing Bowtie API Password",
-			"The provider cannot create the Bowtie API Client without a password",
+			"The provider cannot create the Bowtie API Client without a password or api_token",
 		)
 	}
 
@@ -146,11 +328,44 @@ func (b *BowtieProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		lazy_auth = config.LazyAuthentication.ValueBool()
 	}
 
+	opts := []client.Option{
+		client.WithRetryPolicy(retryPolicy(ctx, config, &resp.Diagnostics)),
+	}
+
+	if timeout, ok := parseDuration(config.HTTPTimeout, path.Root("http_timeout"), &resp.Diagnostics); ok {
+		opts = append(opts, client.WithRequestTimeout(timeout))
+	}
+
+	if !config.RequestsPerSecond.IsNull() && !config.RequestsPerSecond.IsUnknown() {
+		rate := config.RequestsPerSecond.ValueFloat64()
+		opts = append(opts, client.WithRateLimit(rate, int(math.Ceil(rate))))
+	}
+
+	if !config.MaxConcurrentRequests.IsNull() && !config.MaxConcurrentRequests.IsUnknown() {
+		opts = append(opts, client.WithMaxConcurrentRequests(int(config.MaxConcurrentRequests.ValueInt64())))
+	}
+
+	if apiToken != "" {
+		opts = append(opts, client.WithCredentials(client.NewTokenCredentials(apiToken)))
+	}
+
+	if len(failoverHosts) > 0 {
+		opts = append(opts, client.WithFailoverHosts(failoverHosts...))
+	}
+
+	if config.DiscoverControllers.ValueBool() {
+		opts = append(opts, client.WithControllerDiscovery())
+	}
+
+	if tlsConfig := tlsConfig(config, &resp.Diagnostics); tlsConfig != nil {
+		opts = append(opts, client.WithTLSConfig(tlsConfig))
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	client, err := client.NewClient(ctx, host, username, password, lazy_auth)
+	client, err := client.NewClient(ctx, host, username, password, lazy_auth, opts...)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to create Bowtie API Client",
@@ -163,12 +378,144 @@ func (b *BowtieProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	resp.ResourceData = client
 }
 
+// retryPolicy builds the client retry policy from the provider
+// configuration, falling back to the client defaults for unset values.
+func retryPolicy(ctx context.Context, config bowtieProviderModel, diags *diag.Diagnostics) client.RetryPolicy {
+	policy := client.DefaultRetryPolicy()
+
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		policy.MaxAttempts = int(config.MaxRetries.ValueInt64()) + 1
+	}
+
+	if backoff, ok := parseDuration(config.RetryMinBackoff, path.Root("retry_min_backoff"), diags); ok {
+		policy.MinBackoff = backoff
+	}
+
+	if backoff, ok := parseDuration(config.RetryMaxBackoff, path.Root("retry_max_backoff"), diags); ok {
+		policy.MaxBackoff = backoff
+	}
+
+	if policy.MinBackoff > policy.MaxBackoff {
+		diags.AddAttributeError(
+			path.Root("retry_min_backoff"),
+			"Invalid Retry Backoff",
+			fmt.Sprintf("retry_min_backoff (%s) must not be longer than retry_max_backoff (%s)", policy.MinBackoff, policy.MaxBackoff),
+		)
+	}
+
+	if !config.RetryableStatusCodes.IsNull() && !config.RetryableStatusCodes.IsUnknown() {
+		var codes []int64
+		diags.Append(config.RetryableStatusCodes.ElementsAs(ctx, &codes, false)...)
+
+		policy.RetryableStatusCodes = []int{}
+		for _, code := range codes {
+			policy.RetryableStatusCodes = append(policy.RetryableStatusCodes, int(code))
+		}
+	}
+
+	return policy
+}
+
+// tlsConfig builds the TLS configuration for the connection to the
+// controller, or returns nil if none of the TLS attributes are set.
+func tlsConfig(config bowtieProviderModel, diags *diag.Diagnostics) *tls.Config {
+	var options client.TLSOptions
+	configured := false
+
+	pem := func(value, file types.String, attribute string) []byte {
+		if !value.IsNull() && !value.IsUnknown() {
+			configured = true
+			return []byte(value.ValueString())
+		}
+
+		if file.IsNull() || file.IsUnknown() {
+			return nil
+		}
+
+		configured = true
+		contents, err := os.ReadFile(file.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root(attribute+"_file"),
+				"Unreadable TLS File",
+				fmt.Sprintf("The provider cannot read %s: %s", file.ValueString(), err),
+			)
+		}
+		return contents
+	}
+
+	options.CACertificatePEM = pem(config.CACertificate, config.CACertificateFile, "ca_certificate")
+	options.ClientCertificatePEM = pem(config.ClientCertificate, config.ClientCertificateFile, "client_certificate")
+	options.ClientKeyPEM = pem(config.ClientKey, config.ClientKeyFile, "client_key")
+
+	if !config.TLSMinVersion.IsNull() && !config.TLSMinVersion.IsUnknown() {
+		configured = true
+		version, err := client.ParseTLSVersion(config.TLSMinVersion.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("tls_min_version"), "Invalid TLS Version", err.Error())
+		}
+		options.MinVersion = version
+	}
+
+	if !config.InsecureSkipVerify.IsNull() && !config.InsecureSkipVerify.IsUnknown() {
+		configured = true
+		options.InsecureSkipVerify = config.InsecureSkipVerify.ValueBool()
+	}
+
+	if !configured || diags.HasError() {
+		return nil
+	}
+
+	tlsConfig, err := options.Config()
+	if err != nil {
+		diags.AddError(
+			"Invalid TLS Configuration",
+			"The provider cannot configure TLS for the Bowtie API Client: "+err.Error(),
+		)
+		return nil
+	}
+
+	return tlsConfig
+}
+
+func parseDuration(value types.String, attribute path.Path, diags *diag.Diagnostics) (time.Duration, bool) {
+	if value.IsNull() || value.IsUnknown() {
+		return 0, false
+	}
+
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			attribute,
+			"Invalid Duration",
+			fmt.Sprintf("%q is not a valid duration, expected a value such as \"500ms\" or \"30s\": %s", value.ValueString(), err),
+		)
+		return 0, false
+	}
+
+	if duration < 0 {
+		diags.AddAttributeError(
+			attribute,
+			"Invalid Duration",
+			fmt.Sprintf("%q must not be negative", value.ValueString()),
+		)
+		return 0, false
+	}
+
+	return duration, true
+}
+
 func (b *BowtieProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		resources.NewDNSBlockListResource,
 		resources.NewDNSResource,
+		resources.NewControllerResource,
+		resources.NewDeviceResource,
+		resources.NewDeviceGroupResource,
+		resources.NewDeviceGroupMembershipResource,
 		resources.NewGroupResource,
 		resources.NewOrganizationResource,
+		resources.NewPolicyResource,
 		resources.NewSiteRangeResource,
 		resources.NewSiteResource,
 		resources.NewResourceResource,
@@ -180,6 +527,24 @@ func (b *BowtieProvider) Resources(_ context.Context) []func() resource.Resource
 
 func (b *BowtieProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		data_sources.NewControllersDataSource,
+		data_sources.NewDeviceDataSource,
+		data_sources.NewDNSDataSource,
+		data_sources.NewDNSZonesDataSource,
+		data_sources.NewDNSBlockListDataSource,
+		data_sources.NewDNSBlockListsDataSource,
+		data_sources.NewDeviceGroupDataSource,
+		data_sources.NewOrganizationDataSource,
+		data_sources.NewResourceDataSource,
+		data_sources.NewResourcesDataSource,
+		data_sources.NewResourceGroupDataSource,
+		data_sources.NewResourceGroupsDataSource,
+		data_sources.NewSiteDataSource,
+		data_sources.NewSitesDataSource,
+		data_sources.NewDevicesDataSource,
+		data_sources.NewGroupDataSource,
+		data_sources.NewGroupsDataSource,
 		data_sources.NewUserDataSource,
+		data_sources.NewUsersDataSource,
 	}
 }
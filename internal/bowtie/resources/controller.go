@@ -0,0 +1,312 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &controllerResource{}
+var _ resource.ResourceWithImportState = &controllerResource{}
+
+type controllerResource struct {
+	client *client.Client
+}
+
+type controllerResourceModel struct {
+	ID                  types.String   `tfsdk:"id"`
+	LastUpdated         types.String   `tfsdk:"last_updated"`
+	SiteID              types.String   `tfsdk:"site_id"`
+	PublicAddress       types.String   `tfsdk:"public_address"`
+	HTTPSEndpoint       types.String   `tfsdk:"https_endpoint"`
+	WireguardPort       types.Int64    `tfsdk:"wireguard_port"`
+	PersistentKeepalive types.Int64    `tfsdk:"persistent_keepalive"`
+	PublicKey           types.String   `tfsdk:"public_key"`
+	SyncAddress         types.String   `tfsdk:"sync_address"`
+	SyncState           types.String   `tfsdk:"sync_state"`
+	Status              types.String   `tfsdk:"status"`
+	IPV6                types.String   `tfsdk:"ipv6"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewControllerResource() resource.Resource {
+	return &controllerResource{}
+}
+
+func (cr *controllerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_controller"
+}
+
+func (cr *controllerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Attributes the controller reports once it runs. They change
+	// outside of Terraform, so plans leave them unknown.
+	reported := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: description,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Register a Controller with a site ahead of deploying it, and configure how devices and other Controllers reach it.
+
+Once the Controller runs it reports its WireGuard public key, sync address and state, which this resource exposes for use in DNS and load balancer configuration.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Internal resource ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Metadata about the last time a write API was called by this provider for this resource.",
+			},
+			"site_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the site the Controller belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"public_address": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The public IP address or hostname devices use to reach the Controller.",
+			},
+			"https_endpoint": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The HTTPS endpoint serving the Controller's web interface and API, such as `https://bowtie.example.com`. Defaults to one derived from `public_address`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"wireguard_port": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The UDP port the Controller accepts WireGuard connections on. Defaults to the Controller's default port.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"persistent_keepalive": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The WireGuard persistent keepalive interval devices use with the Controller, in seconds.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"public_key":   reported("The WireGuard public key of the Controller."),
+			"sync_address": reported("The address other Controllers use to synchronize with the Controller."),
+			"sync_state":   reported("The state of synchronization with the other Controllers of the organization."),
+			"status":       reported("The status of the Controller."),
+			"ipv6":         reported("The IPv6 address of the Controller within the organization."),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (cr *controllerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T, please report this to the provider.", req.ProviderData),
+		)
+	}
+
+	cr.client = client
+}
+
+func (cr *controllerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan controllerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Create, 0, &resp.Diagnostics)
+	defer cancel()
+
+	id, err := cr.client.CreateController(
+		ctx,
+		plan.SiteID.ValueString(),
+		plan.PublicAddress.ValueString(),
+		plan.HTTPSEndpoint.ValueString(),
+		int(plan.WireguardPort.ValueInt64()),
+		int(plan.PersistentKeepalive.ValueInt64()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating controller",
+			"Could not create the controller, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// The controller exists from here on, so keep track of it even if
+	// reading it back fails. Terraform then taints it rather than
+	// creating a duplicate on the next apply.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(id)
+	cr.refresh(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (cr *controllerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state controllerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := operationContext(ctx, state.Timeouts.Read, 0, &resp.Diagnostics)
+	defer cancel()
+
+	controller, err := cr.client.GetController(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "Controller no longer exists, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error retrieving the controller",
+			fmt.Sprintf("Unexpected error retrieving controller: %s - %+v", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	state.setController(controller)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (cr *controllerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan controllerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Update, 0, &resp.Diagnostics)
+	defer cancel()
+
+	err := cr.client.UpsertController(
+		ctx,
+		plan.ID.ValueString(),
+		plan.SiteID.ValueString(),
+		plan.PublicAddress.ValueString(),
+		plan.HTTPSEndpoint.ValueString(),
+		int(plan.WireguardPort.ValueInt64()),
+		int(plan.PersistentKeepalive.ValueInt64()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating controller",
+			"Could not update controller, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	cr.refresh(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (cr *controllerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state controllerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := operationContext(ctx, state.Timeouts.Delete, 0, &resp.Diagnostics)
+	defer cancel()
+
+	err := cr.client.DeleteController(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to delete the controller",
+			"Unexpected error deleting the controller: "+state.ID.ValueString()+" err: "+err.Error(),
+		)
+	}
+}
+
+func (cr *controllerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// refresh reads back a controller after a write to pick up the defaults
+// the API filled in and the attributes the controller reports.
+func (cr *controllerResource) refresh(ctx context.Context, model *controllerResourceModel, diags *diag.Diagnostics) {
+	controller, err := cr.client.GetController(ctx, model.ID.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error retrieving the controller",
+			fmt.Sprintf("Unexpected error retrieving controller: %s - %+v", model.ID.ValueString(), err),
+		)
+		return
+	}
+
+	model.setController(controller)
+}
+
+// setController copies the attributes of a controller as returned by the
+// API into the model.
+func (m *controllerResourceModel) setController(controller *client.Controller) {
+	m.SiteID = types.StringValue(controller.SiteID)
+	m.PublicAddress = types.StringValue(controller.PublicAddress)
+	m.HTTPSEndpoint = types.StringValue(controller.HTTPSEndpoint)
+	m.WireguardPort = types.Int64Value(int64(controller.WireguardPort))
+	m.PersistentKeepalive = types.Int64Value(int64(controller.PersistentKeepalive))
+	m.PublicKey = types.StringValue(controller.PublicKey)
+	m.SyncAddress = types.StringValue(controller.SyncAddress)
+	m.SyncState = types.StringValue(controller.SyncState)
+	m.Status = types.StringValue(controller.Status)
+	m.IPV6 = types.StringValue(controller.IPV6)
+}
@@ -0,0 +1,87 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// A controller that was created but couldn't be read back must still be
+// saved to state, otherwise the next apply creates it again.
+func TestControllerResource_Create_refreshFails(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/-net/api/v0/user/login":
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "valid", Path: "/"})
+		case r.Method == http.MethodPost:
+			w.WriteHeader(http.StatusOK)
+		default:
+			// The organization doesn't list the new controller yet.
+			_, _ = w.Write([]byte(`{"id": "org", "sites": []}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient(ctx, server.URL, "test@example.com", "passw0rd123", true, client.WithRetryPolicy(client.RetryPolicy{MaxAttempts: 1}))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	cr := &controllerResource{client: c}
+
+	schemaResp := &resource.SchemaResponse{}
+	cr.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	schema := schemaResp.Schema
+
+	plan := tfsdk.Plan{Schema: schema, Raw: tftypes.NewValue(schema.Type().TerraformType(ctx), nil)}
+	diags := plan.Set(ctx, controllerResourceModel{
+		ID:                  types.StringUnknown(),
+		LastUpdated:         types.StringUnknown(),
+		SiteID:              types.StringValue("site"),
+		PublicAddress:       types.StringValue("controller.example.com"),
+		HTTPSEndpoint:       types.StringValue("https://controller.example.com"),
+		WireguardPort:       types.Int64Value(51820),
+		PersistentKeepalive: types.Int64Value(25),
+		PublicKey:           types.StringUnknown(),
+		SyncAddress:         types.StringUnknown(),
+		SyncState:           types.StringUnknown(),
+		Status:              types.StringUnknown(),
+		IPV6:                types.StringUnknown(),
+		Timeouts: timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"read":   types.StringType,
+			"update": types.StringType,
+			"delete": types.StringType,
+		})},
+	})
+	if diags.HasError() {
+		t.Fatalf("Plan.Set() diagnostics = %v", diags)
+	}
+
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: schema, Raw: tftypes.NewValue(schema.Type().TerraformType(ctx), nil)},
+	}
+	cr.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("Create() succeeded, want the read back to fail")
+	}
+
+	var id types.String
+	if diags := resp.State.GetAttribute(ctx, path.Root("id"), &id); diags.HasError() {
+		t.Fatalf("State.GetAttribute() diagnostics = %v", diags)
+	}
+	if id.IsNull() || id.ValueString() == "" {
+		t.Errorf("state id = %v, want the created controller's ID", id)
+	}
+}
@@ -0,0 +1,254 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &deviceResource{}
+var _ resource.ResourceWithImportState = &deviceResource{}
+
+type deviceResource struct {
+	client *client.Client
+}
+
+type deviceResourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	LastUpdated    types.String   `tfsdk:"last_updated"`
+	Name           types.String   `tfsdk:"name"`
+	AssignedToUser types.String   `tfsdk:"assigned_to_user"`
+	State          types.String   `tfsdk:"state"`
+	Serial         types.String   `tfsdk:"serial"`
+	PublicKey      types.String   `tfsdk:"public_key"`
+	IPV6           types.String   `tfsdk:"ipv6"`
+	DeviceType     types.String   `tfsdk:"device_type"`
+	DeviceOS       types.String   `tfsdk:"device_os"`
+	LastSeen       types.String   `tfsdk:"last_seen"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewDeviceResource() resource.Resource {
+	return &deviceResource{}
+}
+
+func (d *deviceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device"
+}
+
+func (d *deviceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Attributes reported by the device itself never change through
+	// Terraform, so they keep their state across plans.
+	readOnly := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: description,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manage devices enrolled with your organization, such as their name, the user they are assigned to and whether they are approved.
+
+**Note**: Devices enroll themselves with a Controller and cannot be created through the Bowtie API.
+This resource will **fail** with an error if any Terraform actions attempt to create devices.
+Instead, you should use an [import](https://developer.hashicorp.com/terraform/language/import) block (or ` + "`terraform import ...`" + ` command) to import an enrolled device which you can then configure normally.
+Destroying the resource removes the device from the organization, which is how a device is offboarded.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": readOnly("Internal resource ID."),
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Metadata about the last time a write API was called by this provider for this resource.",
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				Optional:            true,
+				MarkdownDescription: "The human readable name of the device. Defaults to the name the device enrolled with.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"assigned_to_user": schema.StringAttribute{
+				Computed:            true,
+				Optional:            true,
+				MarkdownDescription: "The ID of the user that owns the device. Defaults to the user the device enrolled as.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				Optional:            true,
+				MarkdownDescription: "Whether the device is approved to connect. Value must be one of `Pending`, `Accepted` or `Rejected`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.DeviceStatePending, client.DeviceStateAccepted, client.DeviceStateRejected),
+				},
+			},
+			"serial":      readOnly("The serial number of the device."),
+			"public_key":  readOnly("The WireGuard public key of the device."),
+			"ipv6":        readOnly("The IPv6 address assigned to the device."),
+			"device_type": readOnly("The type of the device, as reported by the device."),
+			"device_os":   readOnly("The operating system of the device, as reported by the device."),
+			"last_seen": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The last time the device connected to a Controller.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (d *deviceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T, please report this to the provider.", req.ProviderData),
+		)
+	}
+
+	d.client = client
+}
+
+func (d *deviceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.AddError(
+		"Device creation is not supported.",
+		"Devices enroll themselves with a Controller. Please instead use an import block or import command if you would like to manage an enrolled device.",
+	)
+}
+
+func (d *deviceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state deviceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := operationContext(ctx, state.Timeouts.Read, 0, &resp.Diagnostics)
+	defer cancel()
+
+	device, err := d.client.GetDevice(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "Device no longer exists, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error retrieving the device",
+			fmt.Sprintf("Unexpected error retrieving device: %s - %+v", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	state.setDevice(device)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *deviceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan deviceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Update, 0, &resp.Diagnostics)
+	defer cancel()
+
+	err := d.client.UpsertDevice(
+		ctx,
+		plan.ID.ValueString(),
+		plan.Name.ValueString(),
+		plan.AssignedToUser.ValueString(),
+		plan.State.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating device",
+			"Could not update device, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	device, err := d.client.GetDevice(ctx, plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error retrieving the device",
+			fmt.Sprintf("Unexpected error retrieving device: %s - %+v", plan.ID.ValueString(), err),
+		)
+		return
+	}
+
+	plan.setDevice(device)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (d *deviceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state deviceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := operationContext(ctx, state.Timeouts.Delete, 0, &resp.Diagnostics)
+	defer cancel()
+
+	err := d.client.DeleteDevice(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to delete the device",
+			"Unexpected error deleting the device: "+state.ID.ValueString()+" err: "+err.Error(),
+		)
+	}
+}
+
+func (d *deviceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setDevice copies the attributes of a device as returned by the API
+// into the model.
+func (m *deviceResourceModel) setDevice(device *client.Device) {
+	m.Name = types.StringValue(device.Name)
+	m.AssignedToUser = types.StringValue(device.AssignedToUser)
+	m.State = types.StringValue(device.State)
+	m.Serial = types.StringValue(device.Serial)
+	m.PublicKey = types.StringValue(device.PublicKey)
+	m.IPV6 = types.StringValue(device.IPV6)
+	m.DeviceType = types.StringValue(device.DeviceType)
+	m.DeviceOS = types.StringValue(device.DeviceOS)
+	m.LastSeen = types.StringValue(device.LastSeen)
+}
@@ -0,0 +1,197 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DeviceGroupMembershipResource{}
+var _ resource.ResourceWithImportState = &DeviceGroupMembershipResource{}
+
+type DeviceGroupMembershipResource struct {
+	client *client.Client
+}
+
+type deviceGroupMembershipResourceModel struct {
+	GroupID  types.String   `tfsdk:"group_id"`
+	Devices  types.Set      `tfsdk:"devices"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewDeviceGroupMembershipResource() resource.Resource {
+	return &DeviceGroupMembershipResource{}
+}
+
+func (g *DeviceGroupMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_group_membership"
+}
+
+func (g *DeviceGroupMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Used to set the membership of a device group. Will remove any devices not represented in the devices array. Each device group can only be associated with a single membership resource.",
+		Attributes: map[string]schema.Attribute{
+			"group_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the device group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"devices": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				MarkdownDescription: "The IDs of the devices to grant membership to the device group. Will completely overwrite membership on apply.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (g *DeviceGroupMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Incorrect provider data",
+			"The provider data was not appropriate and failed to resolve as *client.Client",
+		)
+	}
+
+	g.client = client
+}
+
+func (g *DeviceGroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan deviceGroupMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Create, 0, &resp.Diagnostics)
+	defer cancel()
+
+	var devices []string
+	resp.Diagnostics.Append(plan.Devices.ElementsAs(ctx, &devices, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := g.client.SetDeviceGroupMembership(ctx, plan.GroupID.ValueString(), devices)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to set device group membership",
+			"Unexpected error setting device group membership: "+plan.GroupID.ValueString()+" err: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (g *DeviceGroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var plan deviceGroupMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Read, 0, &resp.Diagnostics)
+	defer cancel()
+
+	groupInfo, err := g.client.ListDevicesInDeviceGroup(ctx, plan.GroupID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "Device group no longer exists, removing it from state", map[string]interface{}{"id": plan.GroupID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed listing devices in device group",
+			"Unexpected error listing devices in device group: "+plan.GroupID.ValueString()+" err: "+err.Error(),
+		)
+		return
+	}
+
+	stateDevices, diags := types.SetValueFrom(ctx, types.StringType, groupInfo.Devices)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Devices = stateDevices
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (g *DeviceGroupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan deviceGroupMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Update, 0, &resp.Diagnostics)
+	defer cancel()
+
+	var devices []string
+	resp.Diagnostics.Append(plan.Devices.ElementsAs(ctx, &devices, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := g.client.SetDeviceGroupMembership(ctx, plan.GroupID.ValueString(), devices)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to set device group membership",
+			"Unexpected error setting device group membership: "+plan.GroupID.ValueString()+" err: "+err.Error(),
+		)
+		return
+	}
+
+	stateDevices, diags := types.SetValueFrom(ctx, types.StringType, devices)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Devices = stateDevices
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (g *DeviceGroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var plan deviceGroupMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Delete, 0, &resp.Diagnostics)
+	defer cancel()
+
+	err := g.client.SetDeviceGroupMembership(ctx, plan.GroupID.ValueString(), []string{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to remove all devices from the device group",
+			"Unexpected error removing devices from device group: "+plan.GroupID.ValueString()+" err: "+err.Error(),
+		)
+	}
+}
+
+func (g *DeviceGroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("group_id"), req, resp)
+}
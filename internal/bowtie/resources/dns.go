@@ -8,6 +8,7 @@ import (
 
 	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -39,6 +41,7 @@ type dnsResourceModel struct {
 	IsDropAll        types.Bool                `tfsdk:"is_drop_all"`
 	IsSearchDomain   types.Bool                `tfsdk:"is_search_domain"`
 	DNS64Exclude     []dnsExcludeResourceModel `tfsdk:"excludes"`
+	Timeouts         timeouts.Value            `tfsdk:"timeouts"`
 }
 
 type dnsServersResourceModel struct {
@@ -176,6 +179,9 @@ Used to control organization DNS settings. ` + "`{{ .Name }}`" + ` can enable re
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -202,6 +208,9 @@ func (d *dnsResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Create, 0, &resp.Diagnostics)
+	defer cancel()
+
 	servers := []client.Server{}
 	for order, server := range plan.Servers {
 		servers = append(servers, client.Server{
@@ -226,12 +235,13 @@ func (d *dnsResource) Create(ctx context.Context, req resource.CreateRequest, re
 		})
 	}
 
-	id, err := d.client.CreateDNS(plan.Name.ValueString(), servers, includeSites, plan.IsDNS64.ValueBool(), plan.IsCounted.ValueBool(), plan.IsLog.ValueBool(), plan.IsDropA.ValueBool(), plan.IsDropAll.ValueBool(), plan.IsSearchDomain.ValueBool(), excludes)
+	id, err := d.client.CreateDNS(ctx, plan.Name.ValueString(), servers, includeSites, plan.IsDNS64.ValueBool(), plan.IsCounted.ValueBool(), plan.IsLog.ValueBool(), plan.IsDropA.ValueBool(), plan.IsDropAll.ValueBool(), plan.IsSearchDomain.ValueBool(), excludes)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed talking to bowtie server",
 			"Unexpected error creating dns setting: "+err.Error(),
 		)
+		return
 	}
 
 	plan.ID = types.StringValue(id)
@@ -273,7 +283,15 @@ func (d *dnsResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
-	dns, err := d.client.GetDNS(state.ID.ValueString())
+	ctx, cancel := operationContext(ctx, state.Timeouts.Read, 0, &resp.Diagnostics)
+	defer cancel()
+
+	dns, err := d.client.GetDNS(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "DNS zone no longer exists, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed communicating with the bowtie api",
@@ -332,6 +350,9 @@ func (d *dnsResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Update, 0, &resp.Diagnostics)
+	defer cancel()
+
 	var includes []string
 	resp.Diagnostics.Append(plan.IncludeOnlySites.ElementsAs(ctx, &includes, false)...)
 	if resp.Diagnostics.HasError() {
@@ -364,7 +385,7 @@ func (d *dnsResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		})
 	}
 
-	err := d.client.UpsertDNS(plan.ID.ValueString(), plan.Name.ValueString(), servers, includes, plan.IsDNS64.ValueBool(), plan.IsCounted.ValueBool(), plan.IsLog.ValueBool(), plan.IsDropA.ValueBool(), plan.IsDropAll.ValueBool(), plan.IsSearchDomain.ValueBool(), excludes)
+	err := d.client.UpsertDNS(ctx, plan.ID.ValueString(), plan.Name.ValueString(), servers, includes, plan.IsDNS64.ValueBool(), plan.IsCounted.ValueBool(), plan.IsLog.ValueBool(), plan.IsDropA.ValueBool(), plan.IsDropAll.ValueBool(), plan.IsSearchDomain.ValueBool(), excludes)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed updating the dns settings",
@@ -402,7 +423,10 @@ func (d *dnsResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
-	err := d.client.DeleteDNS(plan.ID.ValueString())
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Delete, 0, &resp.Diagnostics)
+	defer cancel()
+
+	err := d.client.DeleteDNS(ctx, plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to delete the dns settings",
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -25,13 +27,20 @@ type dnsBlockListResource struct {
 }
 
 type dnsBlockListResourceModel struct {
-	ID              types.String `tfsdk:"id"`
-	Name            types.String `tfsdk:"name"`
-	LastUpdated     types.String `tfsdk:"last_updated"`
-	Upstream        types.String `tfsdk:"upstream"`
-	OverrideToAllow types.List   `tfsdk:"override_to_allow"`
+	ID              types.String   `tfsdk:"id"`
+	Name            types.String   `tfsdk:"name"`
+	LastUpdated     types.String   `tfsdk:"last_updated"`
+	Upstream        types.String   `tfsdk:"upstream"`
+	OverrideToAllow types.List     `tfsdk:"override_to_allow"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
+// When creating block lists, they are fetched to confirm validity, and so
+// creates and updates default to a longer timeout, which each request may
+// take in full, to ensure that the server has time to perform any
+// requisite GETs for our blocklist URL.
+const dnsBlockListUpsertTimeout = 30 * time.Second
+
 func NewDNSBlockListResource() resource.Resource {
 	return &dnsBlockListResource{}
 }
@@ -97,6 +106,9 @@ Names may be given as upstream URLs which will be retrieved periodically.
 				MarkdownDescription: "Optional list of DNS names to exclude from any retrieved DNS block lists.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -114,10 +126,6 @@ func (bl *dnsBlockListResource) Configure(ctx context.Context, req resource.Conf
 	}
 
 	bl.client = client
-	// When creating block lists, they are fetched to confirm validity,
-	// and so we increase the timeout to ensure that the server has time
-	// to perform any requisite GETs for our blocklist URL.
-	bl.client.HTTPClient.Timeout = 30 * time.Second
 }
 
 func (bl *dnsBlockListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -127,6 +135,9 @@ func (bl *dnsBlockListResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	ctx, cancel := slowOperationContext(ctx, plan.Timeouts.Create, dnsBlockListUpsertTimeout, &resp.Diagnostics)
+	defer cancel()
+
 	overrides := []string{}
 	resp.Diagnostics.Append(plan.OverrideToAllow.ElementsAs(ctx, &overrides, false)...)
 	if resp.Diagnostics.HasError() {
@@ -134,6 +145,7 @@ func (bl *dnsBlockListResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	id, err := bl.client.CreateDNSBlockList(
+		ctx,
 		plan.Name.ValueString(),
 		plan.Upstream.ValueString(),
 		strings.Join(overrides, "\n"),
@@ -160,7 +172,15 @@ func (bl *dnsBlockListResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	blocklist, err := bl.client.GetDNSBlockList(state.ID.ValueString())
+	ctx, cancel := operationContext(ctx, state.Timeouts.Read, 0, &resp.Diagnostics)
+	defer cancel()
+
+	blocklist, err := bl.client.GetDNSBlockList(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "DNS block list no longer exists, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed retrieving DNS block list",
@@ -192,6 +212,9 @@ func (bl *dnsBlockListResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	ctx, cancel := slowOperationContext(ctx, plan.Timeouts.Update, dnsBlockListUpsertTimeout, &resp.Diagnostics)
+	defer cancel()
+
 	overrides := []string{}
 	resp.Diagnostics.Append(plan.OverrideToAllow.ElementsAs(ctx, &overrides, false)...)
 	if resp.Diagnostics.HasError() {
@@ -199,6 +222,7 @@ func (bl *dnsBlockListResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	err := bl.client.UpsertDNSBlockList(
+		ctx,
 		plan.ID.ValueString(),
 		plan.Name.ValueString(),
 		plan.Upstream.ValueString(),
@@ -225,7 +249,10 @@ func (bl *dnsBlockListResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	err := bl.client.DeleteDNSBlockList(state.ID.ValueString())
+	ctx, cancel := operationContext(ctx, state.Timeouts.Delete, 0, &resp.Diagnostics)
+	defer cancel()
+
+	err := bl.client.DeleteDNSBlockList(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed deleting DNS block list",
@@ -6,12 +6,14 @@ import (
 	"time"
 
 	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -23,9 +25,10 @@ type groupResource struct {
 }
 
 type groupResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	LastUpdated types.String `tfsdk:"last_updated"`
+	ID          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewGroupResource() resource.Resource {
@@ -56,6 +59,9 @@ func (g *groupResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				MarkdownDescription: "The human-readable name of the group.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -83,12 +89,16 @@ func (g *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	id, err := g.client.CreateGroup(plan.Name.ValueString())
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Create, 0, &resp.Diagnostics)
+	defer cancel()
+
+	id, err := g.client.CreateGroup(ctx, plan.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating group",
 			"Could not create the group, unexpected error: "+err.Error(),
 		)
+		return
 	}
 
 	plan.ID = types.StringValue(id)
@@ -107,7 +117,15 @@ func (g *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	group, err := g.client.GetGroup(state.ID.ValueString())
+	ctx, cancel := operationContext(ctx, state.Timeouts.Read, 0, &resp.Diagnostics)
+	defer cancel()
+
+	group, err := g.client.GetGroup(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "Group no longer exists, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error retrieving the group",
@@ -128,7 +146,10 @@ func (g *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	id, err := g.client.UpsertGroup(plan.ID.ValueString(), plan.Name.ValueString())
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Update, 0, &resp.Diagnostics)
+	defer cancel()
+
+	id, err := g.client.UpsertGroup(ctx, plan.ID.ValueString(), plan.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating group",
@@ -150,7 +171,10 @@ func (g *groupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := g.client.DeleteGroup(state.ID.ValueString())
+	ctx, cancel := operationContext(ctx, state.Timeouts.Delete, 0, &resp.Diagnostics)
+	defer cancel()
+
+	err := g.client.DeleteGroup(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to delete the group",
@@ -4,12 +4,14 @@ import (
 	"context"
 
 	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -21,8 +23,9 @@ type GroupMembershipResource struct {
 }
 
 type groupMembershipResourceModel struct {
-	GroupID types.String `tfsdk:"group_id"`
-	Users   types.Set    `tfsdk:"users"`
+	GroupID  types.String   `tfsdk:"group_id"`
+	Users    types.Set      `tfsdk:"users"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewGroupMembershipResource() resource.Resource {
@@ -50,6 +53,9 @@ func (g *GroupMembershipResource) Schema(ctx context.Context, req resource.Schem
 				MarkdownDescription: "The list of users to grant membership to the group. This resource accepts both `user_ids` and emails. Will completely overwrite membership on apply.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -76,13 +82,16 @@ func (g *GroupMembershipResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Create, 0, &resp.Diagnostics)
+	defer cancel()
+
 	var users []string
 	resp.Diagnostics.Append(plan.Users.ElementsAs(ctx, &users, false)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	err := g.client.SetGroupMembership(plan.GroupID.ValueString(), users)
+	err := g.client.SetGroupMembership(ctx, plan.GroupID.ValueString(), users)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to set group membership",
@@ -101,7 +110,15 @@ func (g *GroupMembershipResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	groupInfo, err := g.client.ListUsersInGroup(plan.GroupID.ValueString())
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Read, 0, &resp.Diagnostics)
+	defer cancel()
+
+	groupInfo, err := g.client.ListUsersInGroup(ctx, plan.GroupID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "Group no longer exists, removing it from state", map[string]interface{}{"id": plan.GroupID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed listing users in group",
@@ -128,13 +145,16 @@ func (g *GroupMembershipResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Update, 0, &resp.Diagnostics)
+	defer cancel()
+
 	var users []string
 	resp.Diagnostics.Append(plan.Users.ElementsAs(ctx, &users, false)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	err := g.client.SetGroupMembership(plan.GroupID.ValueString(), users)
+	err := g.client.SetGroupMembership(ctx, plan.GroupID.ValueString(), users)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to set group membership",
@@ -161,7 +181,10 @@ func (g *GroupMembershipResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	err := g.client.SetGroupMembership(plan.GroupID.ValueString(), []string{})
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Delete, 0, &resp.Diagnostics)
+	defer cancel()
+
+	err := g.client.SetGroupMembership(ctx, plan.GroupID.ValueString(), []string{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"failed to remove all users from the group",
@@ -0,0 +1,132 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ validator.String = ipv6PrefixValidator{}
+
+// ipv6PrefixValidator checks that a string is an IPv6 CIDR with no host
+// bits set, such as `fd00:b0e7::/48`.
+type ipv6PrefixValidator struct{}
+
+func (v ipv6PrefixValidator) Description(ctx context.Context) string {
+	return "value must be an IPv6 CIDR range with no bits set after the prefix"
+}
+
+func (v ipv6PrefixValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipv6PrefixValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid IPv6 range", fmt.Sprintf("%q is not a CIDR range: %s", value, err))
+		return
+	}
+	if !prefix.Addr().Is6() || prefix.Addr().Is4In6() {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid IPv6 range", fmt.Sprintf("%q is not an IPv6 range.", value))
+		return
+	}
+	if prefix != prefix.Masked() {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid IPv6 range", fmt.Sprintf("%q has bits set after the prefix, did you mean %q?", value, prefix.Masked().String()))
+	}
+}
+
+// ipv6RangeOverlaps describes every way the organization IPv6 ranges
+// overlap each other.
+func ipv6RangeOverlaps(ranges []string) []string {
+	prefixes := parseIPv6Ranges(ranges)
+
+	var overlaps []string
+	for i, prefix := range prefixes {
+		for _, other := range prefixes[i+1:] {
+			if prefix.Overlaps(other) {
+				overlaps = append(overlaps, fmt.Sprintf("%s overlaps %s", prefix, other))
+			}
+		}
+	}
+
+	return overlaps
+}
+
+// ipv6SiteRangeOverlaps describes every way the organization IPv6 ranges
+// overlap the ranges routed by a site. Addresses handed out to devices
+// from an overlapping range could not be told apart from the addresses
+// of the site.
+func ipv6SiteRangeOverlaps(ranges []string, sites []client.Site) []string {
+	var overlaps []string
+	for _, prefix := range parseIPv6Ranges(ranges) {
+		for _, site := range sites {
+			for _, siteRange := range site.RouteRangesV6 {
+				routed, err := netip.ParsePrefix(siteRange.Range)
+				if err != nil {
+					continue
+				}
+				if prefix.Overlaps(routed) {
+					overlaps = append(overlaps, fmt.Sprintf("%s overlaps %s of site %q (range %q)", prefix, routed, site.Name, siteRange.Name))
+				}
+			}
+		}
+	}
+
+	return overlaps
+}
+
+func parseIPv6Ranges(ranges []string) []netip.Prefix {
+	prefixes := make([]netip.Prefix, 0, len(ranges))
+	for _, r := range ranges {
+		prefix, err := netip.ParsePrefix(r)
+		if err != nil {
+			// Malformed ranges are reported by ipv6PrefixValidator.
+			continue
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	return prefixes
+}
+
+// knownIPv6Ranges returns the ranges of a set that are known, so that
+// they can be checked before the rest of them are.
+func knownIPv6Ranges(ctx context.Context, set types.Set, diags *diag.Diagnostics) []string {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+
+	var values []types.String
+	diags.Append(set.ElementsAs(ctx, &values, false)...)
+
+	ranges := []string{}
+	for _, value := range values {
+		if !value.IsNull() && !value.IsUnknown() {
+			ranges = append(ranges, value.ValueString())
+		}
+	}
+	return ranges
+}
+
+func addIPv6RangeOverlapsError(diags *diag.Diagnostics, overlaps []string) {
+	if len(overlaps) == 0 {
+		return
+	}
+
+	diags.AddAttributeError(
+		path.Root("ipv6_ranges"),
+		"Overlapping IPv6 ranges",
+		"The organization IPv6 ranges must not overlap each other or the IPv6 ranges of any site: "+strings.Join(overlaps, "; ")+".",
+	)
+}
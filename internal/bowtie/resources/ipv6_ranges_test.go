@@ -0,0 +1,132 @@
+package resources
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func Test_ipv6PrefixValidator(t *testing.T) {
+	tests := []struct {
+		value   types.String
+		wantErr bool
+	}{
+		{value: types.StringValue("fd00:b0e7::/48")},
+		{value: types.StringValue("2001:db8::/32")},
+		{value: types.StringNull()},
+		{value: types.StringUnknown()},
+		{value: types.StringValue("fd00:b0e7::1/48"), wantErr: true},
+		{value: types.StringValue("10.0.0.0/8"), wantErr: true},
+		{value: types.StringValue("::ffff:10.0.0.0/104"), wantErr: true},
+		{value: types.StringValue("fd00:b0e7::"), wantErr: true},
+		{value: types.StringValue("not a range"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value.String(), func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("ipv6_ranges"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+			ipv6PrefixValidator{}.ValidateString(context.Background(), req, resp)
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Errorf("ValidateString() error = %v, wantErr %v: %v", got, tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func Test_ipv6RangeOverlaps(t *testing.T) {
+	tests := []struct {
+		name   string
+		ranges []string
+		want   []string
+	}{
+		{
+			name:   "disjoint",
+			ranges: []string{"fd00:b0e7::/48", "fd00:b0e8::/48"},
+		},
+		{
+			name:   "each other",
+			ranges: []string{"fd00:b0e7::/48", "fd00:b0e7:0:1::/64"},
+			want:   []string{"fd00:b0e7::/48 overlaps fd00:b0e7:0:1::/64"},
+		},
+		{
+			name:   "site range",
+			ranges: []string{"fd00::/16"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ipv6RangeOverlaps(tt.ranges); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ipv6RangeOverlaps() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_ipv6SiteRangeOverlaps(t *testing.T) {
+	sites := []client.Site{
+		{
+			Name: "Office",
+			RoutableRangesV4: []client.RoutableRange{
+				{Name: "LAN", Range: "10.0.0.0/8"},
+			},
+			RouteRangesV6: []client.RoutableRange{
+				{Name: "LAN", Range: "fd00:1::/64"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		ranges []string
+		want   []string
+	}{
+		{
+			name:   "disjoint",
+			ranges: []string{"fd00:b0e7::/48"},
+		},
+		{
+			name:   "site range",
+			ranges: []string{"fd00::/16"},
+			want:   []string{`fd00::/16 overlaps fd00:1::/64 of site "Office" (range "LAN")`},
+		},
+		{
+			name:   "each other",
+			ranges: []string{"fd00:b0e7::/48", "fd00:b0e7:0:1::/64"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ipv6SiteRangeOverlaps(tt.ranges, sites); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ipv6SiteRangeOverlaps() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_knownIPv6Ranges(t *testing.T) {
+	ctx := context.Background()
+	set := types.SetValueMust(types.StringType, []attr.Value{
+		types.StringValue("fd00:b0e7::/48"),
+		types.StringUnknown(),
+	})
+
+	var diags diag.Diagnostics
+	got := knownIPv6Ranges(ctx, set, &diags)
+	if diags.HasError() {
+		t.Fatalf("knownIPv6Ranges() diagnostics = %v", diags)
+	}
+	if want := []string{"fd00:b0e7::/48"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("knownIPv6Ranges() = %v, want %v", got, want)
+	}
+
+	if got := knownIPv6Ranges(ctx, types.SetUnknown(types.StringType), &diags); got != nil {
+		t.Errorf("knownIPv6Ranges() of an unknown set = %v, want nil", got)
+	}
+}
@@ -5,27 +5,35 @@ import (
 	"time"
 
 	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &organizationResource{}
 var _ resource.ResourceWithImportState = &organizationResource{}
+var _ resource.ResourceWithValidateConfig = &organizationResource{}
+var _ resource.ResourceWithModifyPlan = &organizationResource{}
 
 type organizationResource struct {
 	client *client.Client
 }
 
 type organizationResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	LastUpdated types.String `tfsdk:"last_updated"`
-	Name        types.String `tfsdk:"name"`
-	Domain      types.String `tfsdk:"domain"`
+	ID          types.String   `tfsdk:"id"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	Name        types.String   `tfsdk:"name"`
+	Domain      types.String   `tfsdk:"domain"`
+	IPV6Ranges  types.Set      `tfsdk:"ipv6_ranges"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewOrganizationResource() resource.Resource {
@@ -66,10 +74,65 @@ If you need to remove an organization from your Terraform state, you may remove
 				Required:            true,
 				MarkdownDescription: "Domain to associate with this organization.",
 			},
+			"ipv6_ranges": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The IPv6 CIDR ranges devices of the organization are assigned addresses from, such as `fd00:b0e7::/48`. Ranges may not overlap each other or the IPv6 ranges of any site. Left as configured in Bowtie when unset.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(ipv6PrefixValidator{}),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Read:   true,
+				Update: true,
+			}),
 		},
 	}
 }
 
+func (org *organizationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var ranges types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ipv6_ranges"), &ranges)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addIPv6RangeOverlapsError(&resp.Diagnostics, ipv6RangeOverlaps(knownIPv6Ranges(ctx, ranges, &resp.Diagnostics)))
+}
+
+// ModifyPlan checks the planned IPv6 ranges against the ranges of the
+// sites, which needs the API and so can't happen in ValidateConfig.
+func (org *organizationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || org.client == nil {
+		return
+	}
+
+	var ranges types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("ipv6_ranges"), &ranges)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	known := knownIPv6Ranges(ctx, ranges, &resp.Diagnostics)
+	if len(known) == 0 {
+		return
+	}
+
+	current, err := org.client.GetOrganization(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed retrieving organization information.", err.Error())
+		return
+	}
+
+	addIPv6RangeOverlapsError(&resp.Diagnostics, ipv6SiteRangeOverlaps(known, current.Sites))
+}
+
 func (org *organizationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -100,7 +163,10 @@ func (org *organizationResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	org_response, err := org.client.GetOrganization()
+	ctx, cancel := operationContext(ctx, state.Timeouts.Read, 0, &resp.Diagnostics)
+	defer cancel()
+
+	org_response, err := org.client.GetOrganization(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed retrieving organization information.",
@@ -112,6 +178,17 @@ func (org *organizationResource) Read(ctx context.Context, req resource.ReadRequ
 	state.Name = types.StringValue(org_response.Name)
 	state.Domain = types.StringValue(org_response.Domain)
 
+	ipv6Ranges := org_response.IPV6Ranges
+	if ipv6Ranges == nil {
+		ipv6Ranges = []string{}
+	}
+	ranges, diags := types.SetValueFrom(ctx, types.StringType, ipv6Ranges)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.IPV6Ranges = ranges
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -123,9 +200,39 @@ func (org *organizationResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	err := org.client.UpsertOrganization(
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Update, 0, &resp.Diagnostics)
+	defer cancel()
+
+	current, err := org.client.GetOrganization(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed retrieving organization information.", err.Error())
+		return
+	}
+
+	// The ranges are unknown when they were never read, such as when
+	// updating straight after an import, so keep them as they are.
+	ipv6Ranges := current.IPV6Ranges
+	if !plan.IPV6Ranges.IsUnknown() {
+		resp.Diagnostics.Append(plan.IPV6Ranges.ElementsAs(ctx, &ipv6Ranges, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Sites may have changed since the plan was checked.
+		addIPv6RangeOverlapsError(&resp.Diagnostics, ipv6SiteRangeOverlaps(ipv6Ranges, current.Sites))
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if ipv6Ranges == nil {
+		ipv6Ranges = []string{}
+	}
+
+	err = org.client.UpsertOrganization(
+		ctx,
 		plan.Name.ValueString(),
 		plan.Domain.ValueString(),
+		ipv6Ranges,
 	)
 
 	if err != nil {
@@ -133,6 +240,12 @@ func (org *organizationResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	ranges, diags := types.SetValueFrom(ctx, types.StringType, ipv6Ranges)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.IPV6Ranges = ranges
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
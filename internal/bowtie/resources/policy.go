@@ -1,168 +1,409 @@
 package resources
 
-// import (
-// 	"context"
-// 	"fmt"
-
-// 	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
-// 	"github.com/hashicorp/terraform-plugin-framework/resource"
-// 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-// 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-// 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
-// 	"github.com/hashicorp/terraform-plugin-framework/types"
-// )
-
-// // Ensure provider defined types fully satisfy framework interfaces.
-// var _ resource.Resource = &policyResource{}
-// var _ resource.ResourceWithImportState = &policyResource{}
-
-// type policyResource struct {
-// 	client *client.Client
-// }
-
-// type policyResourceModel struct {
-// 	ID     types.String      `tfsdk:"id"`
-// 	Source policySourceModel `tfsdk:"source"`
-// 	Dest   types.String      `tfsdk:"dest"`
-// 	Action types.String      `tfsdk:"action"`
-// }
-
-// type policySourceModel struct {
-// 	ID        types.String   `tfsdk:"id"`
-// 	Predicate predicateModel `tfsdk:"predicate"`
-// 	Always    types.Bool     `tfsdk:"always"`
-// }
-
-// type predicateModel struct {
-// 	And           types.List   `tfsdk:"and"`
-// 	Or            types.List   `tfsdk:"or"`
-// 	Nor           types.List   `tfsdk:"nor"`
-// 	User          types.String `tfsdk:"user"`
-// 	Device        types.String `tfsdk:"device"`
-// 	InUserGroup   types.String `tfsdk:"user_group"`
-// 	InDeviceGroup types.String `tfsdk:"device_group"`
-// }
-
-// type AndPredicateModel struct {
-// 	And types.List `tfsdk:"And"`
-// }
-
-// func NewPolicyResource() resource.Resource {
-// 	return &policyResource{}
-// }
-
-// func (p *policyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-// 	resp.TypeName = req.ProviderTypeName + "_policy"
-// }
-
-// func (p *policyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-// 	resp.Schema = schema.Schema{
-// 		MarkdownDescription: "",
-// 		Attributes: map[string]schema.Attribute{
-// 			"id": schema.StringAttribute{
-// 				MarkdownDescription: "",
-// 				Computed:            true,
-// 				PlanModifiers: []planmodifier.String{
-// 					stringplanmodifier.UseStateForUnknown(),
-// 				},
-// 			},
-// 			"source": schema.SingleNestedAttribute{
-// 				MarkdownDescription: "",
-// 				Attributes: map[string]schema.Attribute{
-// 					"id": schema.StringAttribute{
-// 						MarkdownDescription: "",
-// 						Computed:            true,
-// 					},
-// 					"predicate": schema.SingleNestedAttribute{
-// 						Attributes: map[string]schema.Attribute{
-// 							"and": schema.ListNestedAttribute{
-// 								NestedObject: schema.NestedAttributeObject{
-// 									Attributes: map[string]schema.Attribute{
-// 										"user":         schema.StringAttribute{},
-// 										"device":       schema.StringAttribute{},
-// 										"user_group":   schema.StringAttribute{},
-// 										"device_group": schema.StringAttribute{},
-// 									},
-// 								},
-// 							},
-// 							"or": schema.ListNestedAttribute{
-// 								NestedObject: schema.NestedAttributeObject{
-// 									Attributes: map[string]schema.Attribute{
-// 										"user":         schema.StringAttribute{},
-// 										"device":       schema.StringAttribute{},
-// 										"user_group":   schema.StringAttribute{},
-// 										"device_group": schema.StringAttribute{},
-// 									},
-// 								},
-// 							},
-// 							"nor": schema.ListNestedAttribute{
-// 								NestedObject: schema.NestedAttributeObject{
-// 									Attributes: map[string]schema.Attribute{
-// 										"user":         schema.StringAttribute{},
-// 										"device":       schema.StringAttribute{},
-// 										"user_group":   schema.StringAttribute{},
-// 										"device_group": schema.StringAttribute{},
-// 									},
-// 								},
-// 							},
-// 							"user":         schema.StringAttribute{},
-// 							"device":       schema.StringAttribute{},
-// 							"user_group":   schema.StringAttribute{},
-// 							"device_group": schema.StringAttribute{},
-// 							"predicate": schema.SingleNestedAttribute{
-// 								Attributes: map[string]schema.Attribute{
-// 									"user":         schema.StringAttribute{},
-// 									"device":       schema.StringAttribute{},
-// 									"user_group":   schema.StringAttribute{},
-// 									"device_group": schema.StringAttribute{},
-// 								},
-// 							},
-// 						},
-// 					},
-// 				},
-// 			},
-// 			"dest": schema.StringAttribute{
-// 				MarkdownDescription: "",
-// 			},
-// 			"action": schema.StringAttribute{
-// 				MarkdownDescription: "",
-// 			},
-// 		},
-// 	}
-// }
-
-// func (p *policyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-// 	if req.ProviderData == nil {
-// 		return
-// 	}
-
-// 	client, ok := req.ProviderData.(*client.Client)
-// 	if !ok {
-// 		resp.Diagnostics.AddError(
-// 			"Unexpected Resource Configuration Type",
-// 			fmt.Sprintf("Expected *client.Client, got: %T, please report this to the provider.", req.ProviderData),
-// 		)
-// 	}
-
-// 	p.client = client
-// }
-
-// func (p *policyResource) Create(ctx context.Context, req resource.CreateRequest, respo *resource.CreateResponse) {
-
-// }
-
-// func (p *policyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-
-// }
-
-// func (p *policyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-
-// }
-
-// func (p *policyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-
-// }
-
-// func (p *policyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-
-// }
+import (
+	"context"
+	"fmt"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &policyResource{}
+var _ resource.ResourceWithImportState = &policyResource{}
+var _ resource.ResourceWithConfigValidators = &policyResource{}
+var _ resource.ResourceWithValidateConfig = &policyResource{}
+
+type policyResource struct {
+	client *client.Client
+}
+
+type policyResourceModel struct {
+	ID       types.String       `tfsdk:"id"`
+	Source   *policySourceModel `tfsdk:"source"`
+	Dest     types.String       `tfsdk:"dest"`
+	Action   types.String       `tfsdk:"action"`
+	Timeouts timeouts.Value     `tfsdk:"timeouts"`
+}
+
+type policySourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	UserID        types.String `tfsdk:"user_id"`
+	UserGroupID   types.String `tfsdk:"user_group_id"`
+	DeviceID      types.String `tfsdk:"device_id"`
+	DeviceGroupID types.String `tfsdk:"device_group_id"`
+	Always        types.Bool   `tfsdk:"always"`
+	Predicate     types.String `tfsdk:"predicate"`
+}
+
+func NewPolicyResource() resource.Resource {
+	return &policyResource{}
+}
+
+func (p *policyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy"
+}
+
+func (p *policyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Bowtie *policies* grant or deny a *source* (a user, device, or group of either) access to a *resource group*.
+
+Exactly one of the ` + "`source`" + ` selectors must be set for each policy. To combine selectors, for example "members of the Engineering group, but not on a particular device", use ` + "`source.predicate`" + `.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Internal resource ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source": schema.SingleNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "Who this policy applies to.",
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Internal ID of the policy source.",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"user_id": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Apply this policy to a single user.",
+					},
+					"user_group_id": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Apply this policy to every member of a user group.",
+					},
+					"device_id": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Apply this policy to a single device.",
+					},
+					"device_group_id": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Apply this policy to every member of a device group, such as one managed with `bowtie_device_group`.",
+					},
+					"always": schema.BoolAttribute{
+						Optional:            true,
+						MarkdownDescription: "Apply this policy to everyone. Must be `true` when set.",
+					},
+					"predicate": schema.StringAttribute{
+						Optional: true,
+						MarkdownDescription: "A JSON encoded predicate, usually built with `jsonencode`, for sources that combine selectors. " +
+							"Each predicate is an object with exactly one key: one of the selectors `user`, `device`, `in_user_group`, `in_device_group` or `always`, " +
+							"or one of the combinators `and`, `or`, `nor` (each a list of predicates) or `not` (a single predicate). Combinators nest to any depth. " +
+							"Empty predicates and predicates that can never match, such as an `and` containing a predicate alongside its own `not`, are rejected.",
+						Validators: []validator.String{
+							&policyPredicateValidator{},
+						},
+					},
+				},
+			},
+			"dest": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the resource group this policy controls access to.",
+			},
+			"action": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "What to do with matching traffic. Value must be one of `Accept` or `Reject`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("Accept", "Reject"),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (p *policyResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("source").AtName("user_id"),
+			path.MatchRoot("source").AtName("user_group_id"),
+			path.MatchRoot("source").AtName("device_id"),
+			path.MatchRoot("source").AtName("device_group_id"),
+			path.MatchRoot("source").AtName("always"),
+			path.MatchRoot("source").AtName("predicate"),
+		),
+	}
+}
+
+func (p *policyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var always types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("source").AtName("always"), &always)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !always.IsNull() && !always.IsUnknown() && !always.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source").AtName("always"),
+			"Invalid policy source",
+			"The always selector may only be set to true. Remove it and set another selector instead.",
+		)
+	}
+}
+
+func (p *policyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configuration Type",
+			fmt.Sprintf("Expected *client.Client, got: %T, please report this to the provider.", req.ProviderData),
+		)
+	}
+
+	p.client = client
+}
+
+func (p *policyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan policyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Create, 0, &resp.Diagnostics)
+	defer cancel()
+
+	predicate, err := plan.Source.predicate()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source"),
+			"Invalid policy source",
+			"Unable to build the policy predicate: "+err.Error(),
+		)
+		return
+	}
+
+	sourceID := uuid.NewString()
+	id, err := p.client.CreatePolicy(ctx, sourceID, predicate, plan.Dest.ValueString(), plan.Action.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create the policy",
+			"Unexpected error creating the policy: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id)
+	plan.Source.ID = types.StringValue(sourceID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (p *policyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state policyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := operationContext(ctx, state.Timeouts.Read, 0, &resp.Diagnostics)
+	defer cancel()
+
+	policy, err := p.client.GetPolicy(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "Policy no longer exists, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read the policy",
+			"Unexpected error reading the policy: "+state.ID.ValueString()+" err: "+err.Error(),
+		)
+		return
+	}
+
+	source, err := policySourceFromAPI(policy.Source, state.Source)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read the policy",
+			"Unable to decode the source of policy: "+state.ID.ValueString()+" err: "+err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(policy.ID)
+	state.Source = source
+	state.Dest = types.StringValue(policy.Dest)
+	state.Action = types.StringValue(policy.Action)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (p *policyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan policyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Update, 0, &resp.Diagnostics)
+	defer cancel()
+
+	sourceID := plan.Source.ID.ValueString()
+	if plan.Source.ID.IsUnknown() || plan.Source.ID.IsNull() {
+		sourceID = uuid.NewString()
+	}
+
+	predicate, err := plan.Source.predicate()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source"),
+			"Invalid policy source",
+			"Unable to build the policy predicate: "+err.Error(),
+		)
+		return
+	}
+
+	err = p.client.UpsertPolicy(ctx, plan.ID.ValueString(), sourceID, predicate, plan.Dest.ValueString(), plan.Action.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed updating the policy",
+			"Unexpected error updating the policy: "+plan.ID.ValueString()+" err: "+err.Error(),
+		)
+		return
+	}
+
+	plan.Source.ID = types.StringValue(sourceID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (p *policyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state policyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := operationContext(ctx, state.Timeouts.Delete, 0, &resp.Diagnostics)
+	defer cancel()
+
+	err := p.client.DeletePolicy(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed deleting the policy",
+			"Unexpected error calling bowtie api to delete policy: "+state.ID.ValueString()+" error: "+err.Error(),
+		)
+	}
+}
+
+func (p *policyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// predicate converts the configured source selector into the API
+// representation.
+func (s *policySourceModel) predicate() (client.BowtiePolicyPredicate, error) {
+	if !s.Predicate.IsNull() {
+		return client.ParsePolicyPredicate(s.Predicate.ValueString())
+	}
+
+	return client.BowtiePolicyPredicate{
+		User:          s.UserID.ValueString(),
+		InUserGroup:   s.UserGroupID.ValueString(),
+		Device:        s.DeviceID.ValueString(),
+		InDeviceGroup: s.DeviceGroupID.ValueString(),
+		Always:        s.Always.ValueBool(),
+	}, nil
+}
+
+// policySourceFromAPI converts the API representation of a policy source
+// back into the resource model. Simple selectors are mapped back onto
+// their dedicated attributes unless the prior state used a predicate, in
+// which case the prior predicate text is kept as long as it still means
+// the same thing to avoid spurious diffs from formatting differences.
+func policySourceFromAPI(source client.BowtiePolicySource, prior *policySourceModel) (*policySourceModel, error) {
+	predicate, err := source.DecodePredicate()
+	if err != nil {
+		return nil, err
+	}
+
+	model := &policySourceModel{
+		ID:            types.StringValue(source.ID),
+		UserID:        types.StringNull(),
+		UserGroupID:   types.StringNull(),
+		DeviceID:      types.StringNull(),
+		DeviceGroupID: types.StringNull(),
+		Always:        types.BoolNull(),
+		Predicate:     types.StringNull(),
+	}
+
+	priorPredicate := prior != nil && !prior.Predicate.IsNull()
+	if priorPredicate || !predicate.IsSelector() {
+		model.Predicate = types.StringValue(predicate.String())
+		if priorPredicate {
+			current, err := client.ParsePolicyPredicate(prior.Predicate.ValueString())
+			if err == nil && current.Equal(predicate) {
+				model.Predicate = prior.Predicate
+			}
+		}
+		return model, nil
+	}
+
+	if predicate.User != "" {
+		model.UserID = types.StringValue(predicate.User)
+	}
+	if predicate.InUserGroup != "" {
+		model.UserGroupID = types.StringValue(predicate.InUserGroup)
+	}
+	if predicate.Device != "" {
+		model.DeviceID = types.StringValue(predicate.Device)
+	}
+	if predicate.InDeviceGroup != "" {
+		model.DeviceGroupID = types.StringValue(predicate.InDeviceGroup)
+	}
+	if predicate.Always {
+		model.Always = types.BoolValue(true)
+	}
+
+	return model, nil
+}
+
+type policyPredicateValidator struct{}
+
+func (v policyPredicateValidator) Description(ctx context.Context) string {
+	return "Ensures that the given string is a valid JSON encoded policy predicate"
+}
+
+func (v policyPredicateValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v policyPredicateValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	_, err := client.ParsePolicyPredicate(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid policy predicate",
+			"Value is not a valid policy predicate: "+err.Error(),
+		)
+	}
+}
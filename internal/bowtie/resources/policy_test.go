@@ -0,0 +1,107 @@
+package resources
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func Test_policySourceFromAPI(t *testing.T) {
+	nested := json.RawMessage(`{"and": [{"in_user_group": "engineering"}, {"not": {"device": "laptop"}}]}`)
+
+	tests := []struct {
+		name          string
+		source        client.BowtiePolicySource
+		prior         *policySourceModel
+		wantUserGroup types.String
+		wantPredicate types.String
+		wantErr       bool
+	}{
+		{
+			name: "selector on import",
+			source: client.BowtiePolicySource{
+				ID:        "source",
+				Predicate: json.RawMessage(`{"in_user_group": "engineering"}`),
+			},
+			prior:         nil,
+			wantUserGroup: types.StringValue("engineering"),
+			wantPredicate: types.StringNull(),
+		},
+		{
+			name: "combinator on import",
+			source: client.BowtiePolicySource{
+				ID:        "source",
+				Predicate: nested,
+			},
+			prior:         nil,
+			wantUserGroup: types.StringNull(),
+			wantPredicate: types.StringValue(`{"and":[{"in_user_group":"engineering"},{"not":{"device":"laptop"}}]}`),
+		},
+		{
+			name: "equivalent prior predicate is kept",
+			source: client.BowtiePolicySource{
+				ID:        "source",
+				Predicate: nested,
+			},
+			prior: &policySourceModel{
+				Predicate: types.StringValue(`{"and": [{"in_user_group": "engineering"}, {"not": {"device": "laptop"}}]}`),
+			},
+			wantUserGroup: types.StringNull(),
+			wantPredicate: types.StringValue(`{"and": [{"in_user_group": "engineering"}, {"not": {"device": "laptop"}}]}`),
+		},
+		{
+			name: "changed prior predicate is replaced",
+			source: client.BowtiePolicySource{
+				ID:        "source",
+				Predicate: nested,
+			},
+			prior: &policySourceModel{
+				Predicate: types.StringValue(`{"in_user_group": "engineering"}`),
+			},
+			wantUserGroup: types.StringNull(),
+			wantPredicate: types.StringValue(`{"and":[{"in_user_group":"engineering"},{"not":{"device":"laptop"}}]}`),
+		},
+		{
+			name: "prior predicate stays a predicate",
+			source: client.BowtiePolicySource{
+				ID:        "source",
+				Predicate: json.RawMessage(`{"in_user_group": "engineering"}`),
+			},
+			prior: &policySourceModel{
+				Predicate: types.StringValue(`{"in_user_group":"engineering"}`),
+			},
+			wantUserGroup: types.StringNull(),
+			wantPredicate: types.StringValue(`{"in_user_group":"engineering"}`),
+		},
+		{
+			name: "undecodable predicate",
+			source: client.BowtiePolicySource{
+				ID:        "source",
+				Predicate: json.RawMessage(`{"user": "jane", "device": "laptop"}`),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := policySourceFromAPI(tt.source, tt.prior)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("policySourceFromAPI() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !got.ID.Equal(types.StringValue(tt.source.ID)) {
+				t.Errorf("policySourceFromAPI().ID = %v, want %v", got.ID, tt.source.ID)
+			}
+			if !got.UserGroupID.Equal(tt.wantUserGroup) {
+				t.Errorf("policySourceFromAPI().UserGroupID = %v, want %v", got.UserGroupID, tt.wantUserGroup)
+			}
+			if !got.Predicate.Equal(tt.wantPredicate) {
+				t.Errorf("policySourceFromAPI().Predicate = %v, want %v", got.Predicate, tt.wantPredicate)
+			}
+		})
+	}
+}
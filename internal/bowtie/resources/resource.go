@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -30,6 +32,7 @@ type resourceResourceModel struct {
 	Protocol types.String           `tfsdk:"protocol"`
 	Location *resourceLocationModel `tfsdk:"location"`
 	Ports    *resourcePortsModel    `tfsdk:"ports"`
+	Timeouts timeouts.Value         `tfsdk:"timeouts"`
 }
 
 type resourceLocationModel struct {
@@ -127,6 +130,9 @@ Note that defining these resources does not implicitly grant or deny access to t
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -153,6 +159,9 @@ func (r *resourceResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Create, 0, &resp.Diagnostics)
+	defer cancel()
+
 	var portsRange []int64
 	var portsCollection []int64
 	if !plan.Ports.Range.IsNull() {
@@ -191,7 +200,15 @@ func (r *resourceResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	resource, err := r.client.GetResource(state.ID.ValueString())
+	ctx, cancel := operationContext(ctx, state.Timeouts.Read, 0, &resp.Diagnostics)
+	defer cancel()
+
+	resource, err := r.client.GetResource(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "Resource no longer exists, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unexpected error retrieving the resource",
@@ -261,6 +278,9 @@ func (r *resourceResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Update, 0, &resp.Diagnostics)
+	defer cancel()
+
 	var portsRange []int64
 	var portsCollection []int64
 	if !plan.Ports.Range.IsNull() {
@@ -297,7 +317,10 @@ func (r *resourceResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	err := r.client.DeleteResource(plan.ID.ValueString())
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Delete, 0, &resp.Diagnostics)
+	defer cancel()
+
+	err := r.client.DeleteResource(ctx, plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"deleting resource failed",
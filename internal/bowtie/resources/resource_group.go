@@ -2,9 +2,9 @@ package resources
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -23,10 +23,11 @@ type resourceGroupResource struct {
 }
 
 type resourceGroupResourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-	Inherited types.List   `tfsdk:"inherited"`
-	Resources types.List   `tfsdk:"resources"`
+	ID        types.String   `tfsdk:"id"`
+	Name      types.String   `tfsdk:"name"`
+	Inherited types.List     `tfsdk:"inherited"`
+	Resources types.List     `tfsdk:"resources"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewResourceGroupResource() resource.Resource {
@@ -62,6 +63,9 @@ func (rg *resourceGroupResource) Schema(ctx context.Context, req resource.Schema
 				Required:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -88,6 +92,9 @@ func (rg *resourceGroupResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Create, 0, &resp.Diagnostics)
+	defer cancel()
+
 	resources := []string{}
 	resp.Diagnostics.Append(plan.Resources.ElementsAs(ctx, &resources, false)...)
 	if resp.Diagnostics.HasError() {
@@ -115,16 +122,21 @@ func (rg *resourceGroupResource) Create(ctx context.Context, req resource.Create
 }
 
 func (rg *resourceGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	tflog.Info(ctx, "START HERE PLEASE")
 	var state resourceGroupResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	tflog.Info(ctx, fmt.Sprintf("!!!!!!!!! %+v", state))
+	ctx, cancel := operationContext(ctx, state.Timeouts.Read, 0, &resp.Diagnostics)
+	defer cancel()
 
-	resourceGroup, err := rg.client.GetResourceGroup(state.ID.ValueString())
+	resourceGroup, err := rg.client.GetResourceGroup(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "Resource group no longer exists, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to read the resource group",
@@ -133,8 +145,6 @@ func (rg *resourceGroupResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	tflog.Info(ctx, fmt.Sprintf("########### %+v - id: %s", resourceGroup, state.ID.ValueString()))
-
 	state.Name = types.StringValue(resourceGroup.Name)
 
 	inherited, diags := types.ListValueFrom(ctx, types.StringType, resourceGroup.Inherited)
@@ -152,8 +162,6 @@ func (rg *resourceGroupResource) Read(ctx context.Context, req resource.ReadRequ
 	state.Resources = resources
 	state.ID = types.StringValue(resourceGroup.ID)
 
-	tflog.Info(ctx, fmt.Sprintf("%+v", state))
-
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -164,6 +172,9 @@ func (rg *resourceGroupResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Update, 0, &resp.Diagnostics)
+	defer cancel()
+
 	resources := []string{}
 	resp.Diagnostics.Append(plan.Resources.ElementsAs(ctx, &resources, false)...)
 	if resp.Diagnostics.HasError() {
@@ -195,7 +206,10 @@ func (rg *resourceGroupResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	err := rg.client.DeleteResourceGroup(plan.ID.ValueString())
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Delete, 0, &resp.Diagnostics)
+	defer cancel()
+
+	err := rg.client.DeleteResourceGroup(ctx, plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed deleting the resource group",
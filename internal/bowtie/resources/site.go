@@ -5,12 +5,14 @@ import (
 	"time"
 
 	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -22,9 +24,10 @@ type siteResource struct {
 }
 
 type siteResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	LastUpdated types.String `tfsdk:"last_updated"`
+	ID          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewSiteResource() resource.Resource {
@@ -59,6 +62,9 @@ If you are managing pre-existing sites, you may wish to import sites as outlined
 				MarkdownDescription: "The human readable name of the site.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -85,7 +91,10 @@ func (s *siteResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	id, err := s.client.CreateSite(plan.Name.ValueString())
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Create, 0, &resp.Diagnostics)
+	defer cancel()
+
+	id, err := s.client.CreateSite(ctx, plan.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed creating site",
@@ -107,7 +116,15 @@ func (s *siteResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	site, err := s.client.GetSite(state.ID.ValueString())
+	ctx, cancel := operationContext(ctx, state.Timeouts.Read, 0, &resp.Diagnostics)
+	defer cancel()
+
+	site, err := s.client.GetSite(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "Site no longer exists, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed retrieving site information from bowtie",
@@ -129,7 +146,10 @@ func (s *siteResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	err := s.client.UpsertSite(plan.ID.ValueString(), plan.Name.ValueString())
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Update, 0, &resp.Diagnostics)
+	defer cancel()
+
+	err := s.client.UpsertSite(ctx, plan.ID.ValueString(), plan.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed updating the site",
@@ -151,7 +171,10 @@ func (s *siteResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	err := s.client.DeleteSite(state.ID.ValueString())
+	ctx, cancel := operationContext(ctx, state.Timeouts.Delete, 0, &resp.Diagnostics)
+	defer cancel()
+
+	err := s.client.DeleteSite(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed deleting the site",
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -26,15 +28,16 @@ type siteRangeResource struct {
 }
 
 type siteRangeResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	SiteID      types.String `tfsdk:"site_id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	IPV4Range   types.String `tfsdk:"ipv4_range"`
-	IPV6Range   types.String `tfsdk:"ipv6_range"`
-	Weight      types.Int64  `tfsdk:"weight"`
-	Metric      types.Int64  `tfsdk:"metric"`
-	LastUpdated types.String `tfsdk:"last_updated"`
+	ID          types.String   `tfsdk:"id"`
+	SiteID      types.String   `tfsdk:"site_id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	IPV4Range   types.String   `tfsdk:"ipv4_range"`
+	IPV6Range   types.String   `tfsdk:"ipv6_range"`
+	Weight      types.Int64    `tfsdk:"weight"`
+	Metric      types.Int64    `tfsdk:"metric"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewSiteRangeResource() resource.Resource {
@@ -100,6 +103,9 @@ A given site may be associated with more than one range.
 				Default:             int64default.StaticInt64(255),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -135,6 +141,9 @@ func (sr *siteRangeResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Create, 0, &resp.Diagnostics)
+	defer cancel()
+
 	var is_ipv4 bool
 	var is_ipv6 bool
 	var cidr string
@@ -152,7 +161,7 @@ func (sr *siteRangeResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	id, err := sr.client.CreateSiteRange(plan.SiteID.ValueString(), plan.Name.ValueString(), plan.Description.ValueString(), cidr, is_ipv4, is_ipv6, plan.Weight.ValueInt64(), plan.Metric.ValueInt64())
+	id, err := sr.client.CreateSiteRange(ctx, plan.SiteID.ValueString(), plan.Name.ValueString(), plan.Description.ValueString(), cidr, is_ipv4, is_ipv6, plan.Weight.ValueInt64(), plan.Metric.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to create the site range",
@@ -174,7 +183,15 @@ func (sr *siteRangeResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	info, err := sr.client.GetSiteRange(state.SiteID.ValueString(), state.ID.ValueString())
+	ctx, cancel := operationContext(ctx, state.Timeouts.Read, 0, &resp.Diagnostics)
+	defer cancel()
+
+	info, err := sr.client.GetSiteRange(ctx, state.SiteID.ValueString(), state.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "Site range no longer exists, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to retrieve site range info from the bowtie server",
@@ -204,6 +221,9 @@ func (sr *siteRangeResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Update, 0, &resp.Diagnostics)
+	defer cancel()
+
 	var is_ipv4 bool
 	var is_ipv6 bool
 	var cidr string
@@ -215,7 +235,7 @@ func (sr *siteRangeResource) Update(ctx context.Context, req resource.UpdateRequ
 		cidr = plan.IPV6Range.ValueString()
 	}
 
-	err := sr.client.UpsertSiteRange(plan.SiteID.ValueString(), plan.ID.ValueString(), plan.Name.ValueString(), plan.Description.ValueString(), cidr, is_ipv4, is_ipv6, plan.Weight.ValueInt64(), plan.Metric.ValueInt64())
+	err := sr.client.UpsertSiteRange(ctx, plan.SiteID.ValueString(), plan.ID.ValueString(), plan.Name.ValueString(), plan.Description.ValueString(), cidr, is_ipv4, is_ipv6, plan.Weight.ValueInt64(), plan.Metric.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed updating site range info",
@@ -236,7 +256,10 @@ func (sr *siteRangeResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	err := sr.client.DeleteSiteRange(state.SiteID.ValueString(), state.ID.ValueString())
+	ctx, cancel := operationContext(ctx, state.Timeouts.Delete, 0, &resp.Diagnostics)
+	defer cancel()
+
+	err := sr.client.DeleteSiteRange(ctx, state.SiteID.ValueString(), state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed deleting site range",
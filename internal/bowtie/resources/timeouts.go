@@ -0,0 +1,39 @@
+package resources
+
+import (
+	"context"
+	"time"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// operationContext bounds a create, read, update or delete by the timeout
+// configured for it in the resource's timeouts block, falling back to
+// defaultTimeout. Zero means no timeout. Either way the provider's
+// http_timeout still bounds each request, so that a hanging controller
+// leaves time to retry or fail over within the operation's timeout.
+func operationContext(ctx context.Context, timeout func(context.Context, time.Duration) (time.Duration, diag.Diagnostics), defaultTimeout time.Duration, diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	duration, timeoutDiags := timeout(ctx, defaultTimeout)
+	diags.Append(timeoutDiags...)
+
+	if duration <= 0 {
+		return ctx, func() {}
+	}
+
+	return context.WithTimeout(ctx, duration)
+}
+
+// slowOperationContext is operationContext for operations whose requests
+// the API is slow to answer. Each request may take up to the whole
+// timeout, rather than only the provider's http_timeout.
+func slowOperationContext(ctx context.Context, timeout func(context.Context, time.Duration) (time.Duration, diag.Diagnostics), defaultTimeout time.Duration, diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	duration, timeoutDiags := timeout(ctx, defaultTimeout)
+	diags.Append(timeoutDiags...)
+
+	if duration <= 0 {
+		return ctx, func() {}
+	}
+
+	return context.WithTimeout(client.ContextWithRequestTimeout(ctx, duration), duration)
+}
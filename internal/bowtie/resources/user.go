@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -26,15 +28,16 @@ type UserResource struct {
 }
 
 type UserResourceModel struct {
-	ID                types.String `tfsdk:"id"`
-	Name              types.String `tfsdk:"name"`
-	Email             types.String `tfsdk:"email"`
-	AuthzDevices      types.Bool   `tfsdk:"authz_devices"`
-	AuthzPolicies     types.Bool   `tfsdk:"authz_policies"`
-	AuthzControlPlane types.Bool   `tfsdk:"authz_control_plane"`
-	AuthzUsers        types.Bool   `tfsdk:"authz_users"`
-	Enabled           types.Bool   `tfsdk:"enabled"`
-	Role              types.String `tfsdk:"role"`
+	ID                types.String   `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	Email             types.String   `tfsdk:"email"`
+	AuthzDevices      types.Bool     `tfsdk:"authz_devices"`
+	AuthzPolicies     types.Bool     `tfsdk:"authz_policies"`
+	AuthzControlPlane types.Bool     `tfsdk:"authz_control_plane"`
+	AuthzUsers        types.Bool     `tfsdk:"authz_users"`
+	Enabled           types.Bool     `tfsdk:"enabled"`
+	Role              types.String   `tfsdk:"role"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewUserResource() resource.Resource {
@@ -116,6 +119,9 @@ func (u *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				MarkdownDescription: "Configures if the user is `Active` or `Disabled`.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -142,6 +148,9 @@ func (u *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Create, 0, &resp.Diagnostics)
+	defer cancel()
+
 	id, err := u.client.CreateUser(ctx, plan.Name.ValueString(), plan.Email.ValueString(), plan.Role.ValueString(), plan.AuthzPolicies.ValueBool(), plan.AuthzUsers.ValueBool(), plan.AuthzControlPlane.ValueBool(), plan.AuthzDevices.ValueBool(), plan.Enabled.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -163,12 +172,21 @@ func (u *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, state.Timeouts.Read, 0, &resp.Diagnostics)
+	defer cancel()
+
 	user, err := u.client.GetUser(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "User no longer exists, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed reading the user: "+state.ID.ValueString(),
 			"Unexpected error reading the user: "+err.Error(),
 		)
+		return
 	}
 
 	state.Name = types.StringValue(user.Name)
@@ -192,6 +210,9 @@ func (u *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Update, 0, &resp.Diagnostics)
+	defer cancel()
+
 	_, err := u.client.UpsertUser(ctx, plan.ID.ValueString(), plan.Name.ValueString(), plan.Email.ValueString(), plan.Role.ValueString(), plan.AuthzPolicies.ValueBool(), plan.AuthzUsers.ValueBool(), plan.AuthzControlPlane.ValueBool(), plan.AuthzDevices.ValueBool(), plan.Enabled.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -211,6 +232,9 @@ func (u *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	ctx, cancel := operationContext(ctx, plan.Timeouts.Delete, 0, &resp.Diagnostics)
+	defer cancel()
+
 	err := u.client.DeleteUser(ctx, plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
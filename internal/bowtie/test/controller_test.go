@@ -0,0 +1,60 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/provider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccControllerResource(t *testing.T) {
+	config := func(publicAddress string) string {
+		return provider.ProviderConfig + `
+resource "bowtie_site" "test" {
+  name = "Controller Site"
+}
+
+resource "bowtie_controller" "test" {
+  site_id        = bowtie_site.test.id
+  public_address = "` + publicAddress + `"
+  wireguard_port = 51820
+}
+
+data "bowtie_controllers" "test" {
+  site_id = bowtie_controller.test.site_id
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config("203.0.113.10"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("bowtie_controller.test", "public_address", "203.0.113.10"),
+					resource.TestCheckResourceAttr("bowtie_controller.test", "wireguard_port", "51820"),
+					resource.TestCheckResourceAttrPair("bowtie_controller.test", "site_id", "bowtie_site.test", "id"),
+					resource.TestCheckResourceAttrSet("bowtie_controller.test", "id"),
+					resource.TestCheckResourceAttr("data.bowtie_controllers.test", "controllers.#", "1"),
+					resource.TestCheckResourceAttrPair("data.bowtie_controllers.test", "controllers.0.id", "bowtie_controller.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "bowtie_controller.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: config("203.0.113.20"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("bowtie_controller.test", "public_address", "203.0.113.20"),
+				),
+			},
+		},
+	})
+}
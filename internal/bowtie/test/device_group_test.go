@@ -0,0 +1,53 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/provider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeviceGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: provider.ProviderConfig + `
+resource "bowtie_device_group" "test" {
+  name = "CI Runners"
+}
+
+data "bowtie_device_group" "test" {
+  name = bowtie_device_group.test.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("bowtie_device_group.test", "name", "CI Runners"),
+					resource.TestCheckResourceAttrSet("bowtie_device_group.test", "id"),
+					resource.TestCheckResourceAttrSet("bowtie_device_group.test", "last_updated"),
+					resource.TestCheckResourceAttrPair("data.bowtie_device_group.test", "id", "bowtie_device_group.test", "id"),
+					resource.TestCheckResourceAttr("data.bowtie_device_group.test", "devices.#", "0"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "bowtie_device_group.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: provider.ProviderConfig + `
+resource "bowtie_device_group" "test" {
+  name = "Build Agents"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("bowtie_device_group.test", "name", "Build Agents"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,109 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/client"
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/provider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const resourceDevice = "bowtie_device.test"
+
+func TestAccDeviceResource(t *testing.T) {
+	var device client.Device
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			device = getEnrolledDevice(t)
+		},
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Import testing. Devices enroll themselves and cannot be
+			// created, and destroying the resource would remove the
+			// device from the test environment, so we only import an
+			// already enrolled device.
+			{
+				Config:       getDeviceConfig(resourceDevice),
+				ResourceName: resourceDevice,
+				ImportState:  true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					return device.ID, nil
+				},
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported device, got %d", len(states))
+					}
+
+					attributes := states[0].Attributes
+					for attribute, want := range map[string]string{
+						"id":               device.ID,
+						"name":             device.Name,
+						"assigned_to_user": device.AssignedToUser,
+						"state":            device.State,
+						"serial":           device.Serial,
+						"public_key":       device.PublicKey,
+					} {
+						if attributes[attribute] != want {
+							return fmt.Errorf("expected %s to be %q, got %q", attribute, want, attributes[attribute])
+						}
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+// getEnrolledDevice returns any device enrolled with the test
+// environment, skipping the test if there is none.
+func getEnrolledDevice(t *testing.T) client.Device {
+	ctx := context.Background()
+
+	c, err := getBowtieClient(ctx, "http://localhost:3000")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	devices, err := c.ListDevices(ctx)
+	if err != nil {
+		t.Fatalf("failed to list devices: %v", err)
+	}
+
+	for _, device := range devices {
+		return device
+	}
+
+	t.Skip("no device is enrolled with the test environment")
+	return client.Device{}
+}
+
+func getDeviceConfig(resource string) string {
+	funcMap := template.FuncMap{
+		"notNil": func(val any) bool {
+			return val != nil
+		},
+	}
+
+	tmpl, err := template.New("").Funcs(funcMap).ParseGlob("testdata/*.tmpl")
+	if err != nil {
+		return ""
+	}
+
+	var output *strings.Builder = &strings.Builder{}
+	err = tmpl.ExecuteTemplate(output, "device.tmpl", map[string]interface{}{
+		"provider": provider.ProviderConfig,
+		"resource": strings.Split(resource, ".")[1],
+	})
+
+	if err != nil {
+		panic("Failed to render template")
+	}
+
+	return output.String()
+}
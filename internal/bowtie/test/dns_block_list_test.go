@@ -90,3 +90,31 @@ func getDNSBlockListConfig(resource string, name string, url string, overrides [
 
 	return output.String()
 }
+
+func TestDNSBlockListDataSources(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: getDNSBlockListConfig(resourceName, blName, blUrl, blOverride) + `
+data "bowtie_dns_block_list" "test" {
+  name = bowtie_dns_block_list.test.name
+}
+
+data "bowtie_dns_block_lists" "test" {
+  name_regex = "^` + blName + `$"
+
+  depends_on = [bowtie_dns_block_list.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.bowtie_dns_block_list.test", "id", resourceName, "id"),
+					resource.TestCheckResourceAttr("data.bowtie_dns_block_list.test", "upstream", blUrl),
+					resource.TestCheckResourceAttr("data.bowtie_dns_block_list.test", "override_to_allow.#", "2"),
+					resource.TestCheckResourceAttr("data.bowtie_dns_block_lists.test", "dns_block_lists.#", "1"),
+					resource.TestCheckResourceAttrPair("data.bowtie_dns_block_lists.test", "dns_block_lists.0.id", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
@@ -235,3 +235,32 @@ func getDNSConfig(name string, servers, excludes, sites []string) string {
 
 	return output.String()
 }
+
+func TestAccDNSDataSources(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: getDNSConfig("data-source.example.com", []string{"1.1.1.1", "4.4.4.4"}, []string{"wrong.example.com"}, nil) + `
+data "bowtie_dns" "test" {
+  name = bowtie_dns.test.name
+}
+
+data "bowtie_dns_zones" "test" {
+  name_regex = "^data-source\\.example\\.com$"
+
+  depends_on = [bowtie_dns.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.bowtie_dns.test", "id", "bowtie_dns.test", "id"),
+					resource.TestCheckResourceAttr("data.bowtie_dns.test", "servers.0.addr", "1.1.1.1"),
+					resource.TestCheckResourceAttr("data.bowtie_dns.test", "servers.1.addr", "4.4.4.4"),
+					resource.TestCheckResourceAttr("data.bowtie_dns.test", "excludes.0.name", "wrong.example.com"),
+					resource.TestCheckResourceAttr("data.bowtie_dns_zones.test", "dns_zones.#", "1"),
+					resource.TestCheckResourceAttrPair("data.bowtie_dns_zones.test", "dns_zones.0.id", "bowtie_dns.test", "id"),
+				),
+			},
+		},
+	})
+}
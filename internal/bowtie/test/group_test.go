@@ -0,0 +1,39 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/provider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGroupDataSources(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: provider.ProviderConfig + `
+resource "bowtie_group" "test" {
+  name = "Data Source Group"
+}
+
+data "bowtie_group" "test" {
+  name = bowtie_group.test.name
+}
+
+data "bowtie_groups" "test" {
+  name_regex = "^Data Source Group$"
+
+  depends_on = [bowtie_group.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.bowtie_group.test", "id", "bowtie_group.test", "id"),
+					resource.TestCheckResourceAttr("data.bowtie_group.test", "users.#", "0"),
+					resource.TestCheckResourceAttr("data.bowtie_groups.test", "groups.#", "1"),
+					resource.TestCheckResourceAttrPair("data.bowtie_groups.test", "groups.0.id", "bowtie_group.test", "id"),
+				),
+			},
+		},
+	})
+}
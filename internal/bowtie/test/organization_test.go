@@ -88,7 +88,7 @@ func getOrgId() resource.ImportStateIdFunc {
 			return "", err
 		}
 
-		org, err := client.GetOrganization()
+		org, err := client.GetOrganization(ctx)
 		if err != nil {
 			return "", err
 		}
@@ -96,3 +96,29 @@ func getOrgId() resource.ImportStateIdFunc {
 		return org.ID, nil
 	}
 }
+
+func TestAccOrganizationDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: provider.ProviderConfig + `
+resource "bowtie_site" "test" {
+  name = "Organization Site"
+}
+
+data "bowtie_organization" "test" {
+  depends_on = [bowtie_site.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.bowtie_organization.test", "id"),
+					resource.TestCheckResourceAttrSet("data.bowtie_organization.test", "name"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.bowtie_organization.test", "sites.*", map[string]string{
+						"name": "Organization Site",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,90 @@
+package test
+
+import (
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/provider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccPolicyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: getPolicyConfig("Accept"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectNonEmptyPlan(),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("bowtie_policy.test", "action", "Accept"),
+					resource.TestCheckResourceAttrPair("bowtie_policy.test", "dest", "bowtie_resource_group.tools", "id"),
+					resource.TestCheckResourceAttrPair("bowtie_policy.test", "source.user_group_id", "bowtie_group.engineering", "id"),
+					resource.TestCheckResourceAttrSet("bowtie_policy.test", "source.id"),
+					resource.TestCheckResourceAttrSet("bowtie_policy.test", "id"),
+					resource.TestCheckResourceAttrSet("bowtie_policy.predicate", "source.predicate"),
+					resource.TestCheckNoResourceAttr("bowtie_policy.predicate", "source.user_group_id"),
+				),
+			},
+			{
+				ResourceName:      "bowtie_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "bowtie_policy.predicate",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The predicate is re-encoded by the provider on import
+				// and so only matches semantically.
+				ImportStateVerifyIgnore: []string{"source.predicate"},
+			},
+			{
+				Config: getPolicyConfig("Reject"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectNonEmptyPlan(),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("bowtie_policy.test", "action", "Reject"),
+				),
+			},
+		},
+	})
+}
+
+func getPolicyConfig(action string) string {
+	funcMap := template.FuncMap{
+		"notNil": func(val any) bool {
+			return val != nil
+		},
+	}
+
+	tmpl, err := template.New("").Funcs(funcMap).ParseGlob("testdata/*.tmpl")
+	if err != nil {
+		return ""
+	}
+
+	var output *strings.Builder = &strings.Builder{}
+	err = tmpl.ExecuteTemplate(output, "policy.tmpl", map[string]interface{}{
+		"provider": provider.ProviderConfig,
+		"action":   action,
+	})
+	if err != nil {
+		panic("Failed to render template")
+	}
+
+	return output.String()
+}
@@ -0,0 +1,73 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/provider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceDataSources(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: provider.ProviderConfig + `
+resource "bowtie_resource" "test" {
+  name     = "Data Source Resource"
+  protocol = "https"
+  location = {
+    dns = "data-source.example.com"
+  }
+  ports = {
+    collection = [443]
+  }
+}
+
+resource "bowtie_resource_group" "inner" {
+  name      = "Data Source Inner"
+  resources = [bowtie_resource.test.id]
+}
+
+resource "bowtie_resource_group" "outer" {
+  name      = "Data Source Outer"
+  resources = []
+  inherited = [bowtie_resource_group.inner.id]
+}
+
+data "bowtie_resource" "test" {
+  location = {
+    dns = bowtie_resource.test.location.dns
+  }
+}
+
+data "bowtie_resources" "test" {
+  protocol = "https"
+  dns      = bowtie_resource.test.location.dns
+}
+
+data "bowtie_resource_group" "test" {
+  name = bowtie_resource_group.outer.name
+}
+
+data "bowtie_resource_groups" "test" {
+  name_regex = "^Data Source (Inner|Outer)$"
+
+  depends_on = [bowtie_resource_group.inner, bowtie_resource_group.outer]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.bowtie_resource.test", "id", "bowtie_resource.test", "id"),
+					resource.TestCheckResourceAttr("data.bowtie_resource.test", "ports.collection.0", "443"),
+					resource.TestCheckResourceAttr("data.bowtie_resources.test", "resources.#", "1"),
+					resource.TestCheckResourceAttrPair("data.bowtie_resources.test", "resources.0.id", "bowtie_resource.test", "id"),
+					resource.TestCheckResourceAttrPair("data.bowtie_resource_group.test", "id", "bowtie_resource_group.outer", "id"),
+					resource.TestCheckResourceAttr("data.bowtie_resource_group.test", "resources.#", "0"),
+					resource.TestCheckResourceAttrPair("data.bowtie_resource_group.test", "all_inherited.0", "bowtie_resource_group.inner", "id"),
+					resource.TestCheckResourceAttrPair("data.bowtie_resource_group.test", "all_resources.0", "bowtie_resource.test", "id"),
+					resource.TestCheckResourceAttr("data.bowtie_resource_groups.test", "resource_groups.#", "2"),
+				),
+			},
+		},
+	})
+}
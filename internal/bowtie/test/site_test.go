@@ -1,10 +1,14 @@
 package test
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
 	"github.com/bowtieworks/terraform-provider-bowtie/internal/bowtie/provider"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccSiteResource(t *testing.T) {
@@ -36,3 +40,92 @@ resource "bowtie_site" "test" {
 		},
 	})
 }
+
+func TestAccSiteResource_disappears(t *testing.T) {
+	var siteID string
+
+	config := provider.ProviderConfig + `
+resource "bowtie_site" "test" {
+  name = "Disappearing Site"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: func(s *terraform.State) error {
+					rs, ok := s.RootModule().Resources["bowtie_site.test"]
+					if !ok {
+						return fmt.Errorf("bowtie_site.test not found in state")
+					}
+					siteID = rs.Primary.ID
+					return nil
+				},
+			},
+			// Delete the site behind Terraform's back; the next plan
+			// should recreate it rather than fail to refresh.
+			{
+				PreConfig: func() {
+					ctx := context.Background()
+					c, err := getBowtieClient(ctx, "http://127.0.0.1:3000")
+					if err != nil {
+						t.Fatalf("failed to create client: %v", err)
+					}
+					if err := c.DeleteSite(ctx, siteID); err != nil {
+						t.Fatalf("failed to delete site out-of-band: %v", err)
+					}
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("bowtie_site.test", plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttrSet("bowtie_site.test", "id"),
+			},
+		},
+	})
+}
+
+func TestAccSiteDataSources(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: provider.ProviderConfig + `
+resource "bowtie_site" "test" {
+  name = "Data Source Site"
+}
+
+resource "bowtie_site_range" "test" {
+  site_id    = bowtie_site.test.id
+  name       = "Data Source Range"
+  ipv4_range = "10.99.0.0/16"
+}
+
+data "bowtie_site" "test" {
+  name = bowtie_site.test.name
+
+  depends_on = [bowtie_site_range.test]
+}
+
+data "bowtie_sites" "test" {
+  name_regex = "^Data Source Site$"
+
+  depends_on = [bowtie_site_range.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.bowtie_site.test", "id", "bowtie_site.test", "id"),
+					resource.TestCheckResourceAttr("data.bowtie_site.test", "ipv4_ranges.#", "1"),
+					resource.TestCheckResourceAttr("data.bowtie_site.test", "ipv4_ranges.0.name", "Data Source Range"),
+					resource.TestCheckResourceAttr("data.bowtie_site.test", "ipv4_ranges.0.range", "10.99.0.0/16"),
+					resource.TestCheckResourceAttr("data.bowtie_sites.test", "sites.#", "1"),
+					resource.TestCheckResourceAttrPair("data.bowtie_sites.test", "sites.0.id", "bowtie_site.test", "id"),
+				),
+			},
+		},
+	})
+}
@@ -22,7 +22,7 @@ func init() {
 				return err
 			}
 
-			users, err := client.GetUsers()
+			users, err := client.GetUsers(ctx)
 			if err != nil {
 				return err
 			}
@@ -155,3 +155,32 @@ func getUserConfig(name, email, role string, authz, authz_users, authz_devices,
 
 	return output.String()
 }
+
+func TestAccUsersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: provider.ProviderConfig + `
+resource "bowtie_user" "test" {
+  name  = "Users Data Source"
+  email = "users-data-source@example.net"
+  role  = "User"
+}
+
+data "bowtie_users" "test" {
+  email_domain = "example.net"
+  email_regex  = "^users-data-source@"
+
+  depends_on = [bowtie_user.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bowtie_users.test", "users.#", "1"),
+					resource.TestCheckResourceAttrPair("data.bowtie_users.test", "users.0.id", "bowtie_user.test", "id"),
+					resource.TestCheckResourceAttr("data.bowtie_users.test", "users.0.role", "User"),
+				),
+			},
+		},
+	})
+}